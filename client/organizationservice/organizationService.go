@@ -14,20 +14,75 @@ import (
 )
 
 type (
-	CreateOrganizationRequest  = organization.CreateOrganizationRequest
-	CreateOrganizationResponse = organization.CreateOrganizationResponse
-	DeleteOrganizationRequest  = organization.DeleteOrganizationRequest
-	DeleteOrganizationResponse = organization.DeleteOrganizationResponse
-	GetAncestorsRequest        = organization.GetAncestorsRequest
-	GetAncestorsResponse       = organization.GetAncestorsResponse
-	GetDescendantsRequest      = organization.GetDescendantsRequest
-	GetDescendantsResponse     = organization.GetDescendantsResponse
-	GetOrganizationRequest     = organization.GetOrganizationRequest
-	ListOrganizationsRequest   = organization.ListOrganizationsRequest
-	ListOrganizationsResponse  = organization.ListOrganizationsResponse
-	Organization               = organization.Organization
-	OrganizationTree           = organization.OrganizationTree
-	UpdateOrganizationRequest  = organization.UpdateOrganizationRequest
+	AddMemberRequest                    = organization.AddMemberRequest
+	AdminGrant                          = organization.AdminGrant
+	AssignPositionRequest               = organization.AssignPositionRequest
+	AuditLogEntry                       = organization.AuditLogEntry
+	BatchGetOrganizationsByCodeRequest  = organization.BatchGetOrganizationsByCodeRequest
+	BatchGetOrganizationsByCodeResponse = organization.BatchGetOrganizationsByCodeResponse
+	CreateOrganizationRequest           = organization.CreateOrganizationRequest
+	CreateOrganizationResponse          = organization.CreateOrganizationResponse
+	CreatePositionRequest               = organization.CreatePositionRequest
+	CreateWebhookRequest                = organization.CreateWebhookRequest
+	CreateWebhookResponse               = organization.CreateWebhookResponse
+	DeleteOrganizationRequest           = organization.DeleteOrganizationRequest
+	DeleteOrganizationResponse          = organization.DeleteOrganizationResponse
+	DeletePositionRequest               = organization.DeletePositionRequest
+	DeletePositionResponse              = organization.DeletePositionResponse
+	DeleteWebhookRequest                = organization.DeleteWebhookRequest
+	DeleteWebhookResponse               = organization.DeleteWebhookResponse
+	DisableOrganizationRequest          = organization.DisableOrganizationRequest
+	EnableOrganizationRequest           = organization.EnableOrganizationRequest
+	GetAncestorsRequest                 = organization.GetAncestorsRequest
+	GetAncestorsResponse                = organization.GetAncestorsResponse
+	GetDescendantsRequest               = organization.GetDescendantsRequest
+	GetDescendantsResponse              = organization.GetDescendantsResponse
+	GetManagerChainRequest              = organization.GetManagerChainRequest
+	GetManagerChainResponse             = organization.GetManagerChainResponse
+	GetOrganizationByCodeRequest        = organization.GetOrganizationByCodeRequest
+	GetOrganizationRequest              = organization.GetOrganizationRequest
+	GrantAdminRequest                   = organization.GrantAdminRequest
+	IdRange                             = organization.IdRange
+	ListAdminGrantsRequest              = organization.ListAdminGrantsRequest
+	ListAdminGrantsResponse             = organization.ListAdminGrantsResponse
+	ListAuditLogRequest                 = organization.ListAuditLogRequest
+	ListAuditLogResponse                = organization.ListAuditLogResponse
+	ListMembersRequest                  = organization.ListMembersRequest
+	ListMembersResponse                 = organization.ListMembersResponse
+	ListOrganizationsOfUserRequest      = organization.ListOrganizationsOfUserRequest
+	ListOrganizationsOfUserResponse     = organization.ListOrganizationsOfUserResponse
+	ListOrganizationsRequest            = organization.ListOrganizationsRequest
+	ListOrganizationsResponse           = organization.ListOrganizationsResponse
+	ListPositionsRequest                = organization.ListPositionsRequest
+	ListPositionsResponse               = organization.ListPositionsResponse
+	ListWebhookAttemptsRequest          = organization.ListWebhookAttemptsRequest
+	ListWebhookAttemptsResponse         = organization.ListWebhookAttemptsResponse
+	ListWebhooksRequest                 = organization.ListWebhooksRequest
+	ListWebhooksResponse                = organization.ListWebhooksResponse
+	ManagerChainItem                    = organization.ManagerChainItem
+	Membership                          = organization.Membership
+	MoveOrganizationRequest             = organization.MoveOrganizationRequest
+	Organization                        = organization.Organization
+	OrganizationChanged                 = organization.OrganizationChanged
+	OrganizationTree                    = organization.OrganizationTree
+	Position                            = organization.Position
+	RemoveMemberRequest                 = organization.RemoveMemberRequest
+	RemoveMemberResponse                = organization.RemoveMemberResponse
+	ReorderChildrenRequest              = organization.ReorderChildrenRequest
+	ReorderChildrenResponse             = organization.ReorderChildrenResponse
+	ResolveDataScopeRequest             = organization.ResolveDataScopeRequest
+	ResolveDataScopeResponse            = organization.ResolveDataScopeResponse
+	RestoreOrganizationRequest          = organization.RestoreOrganizationRequest
+	RevokeAdminRequest                  = organization.RevokeAdminRequest
+	RevokeAdminResponse                 = organization.RevokeAdminResponse
+	UpdateOrganizationRequest           = organization.UpdateOrganizationRequest
+	UserOrganization                    = organization.UserOrganization
+	WatchOrganizationsRequest           = organization.WatchOrganizationsRequest
+	WatchOrganizationsResponse          = organization.WatchOrganizationsResponse
+	WatchResync                         = organization.WatchResync
+	WatchSnapshot                       = organization.WatchSnapshot
+	Webhook                             = organization.Webhook
+	WebhookAttempt                      = organization.WebhookAttempt
 
 	OrganizationService interface {
 		// CreateOrganization 创建组织节点
@@ -44,6 +99,24 @@ type (
 		GetAncestors(ctx context.Context, in *GetAncestorsRequest, opts ...grpc.CallOption) (*GetAncestorsResponse, error)
 		// GetDescendants 获取后代树
 		GetDescendants(ctx context.Context, in *GetDescendantsRequest, opts ...grpc.CallOption) (*GetDescendantsResponse, error)
+		// MoveOrganization 移动组织节点到新的父节点下
+		MoveOrganization(ctx context.Context, in *MoveOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+		// DisableOrganization 禁用组织节点
+		DisableOrganization(ctx context.Context, in *DisableOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+		// EnableOrganization 启用组织节点
+		EnableOrganization(ctx context.Context, in *EnableOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+		// RestoreOrganization 恢复已删除的组织节点
+		RestoreOrganization(ctx context.Context, in *RestoreOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+		// GetOrganizationByCode 按组织编码获取组织节点
+		GetOrganizationByCode(ctx context.Context, in *GetOrganizationByCodeRequest, opts ...grpc.CallOption) (*Organization, error)
+		// BatchGetOrganizationsByCode 按组织编码批量获取组织节点
+		BatchGetOrganizationsByCode(ctx context.Context, in *BatchGetOrganizationsByCodeRequest, opts ...grpc.CallOption) (*BatchGetOrganizationsByCodeResponse, error)
+		// ReorderChildren 按给定顺序重排子节点
+		ReorderChildren(ctx context.Context, in *ReorderChildrenRequest, opts ...grpc.CallOption) (*ReorderChildrenResponse, error)
+		// ResolveDataScope ResolveDataScope 解析数据权限范围，返回可访问的组织 ID
+		ResolveDataScope(ctx context.Context, in *ResolveDataScopeRequest, opts ...grpc.CallOption) (*ResolveDataScopeResponse, error)
+		// WatchOrganizations WatchOrganizations 订阅组织树变更，先推送子树快照，再按版本顺序推送变更事件
+		WatchOrganizations(ctx context.Context, in *WatchOrganizationsRequest, opts ...grpc.CallOption) (organization.OrganizationService_WatchOrganizationsClient, error)
 	}
 
 	defaultOrganizationService struct {
@@ -98,3 +171,57 @@ func (m *defaultOrganizationService) GetDescendants(ctx context.Context, in *Get
 	client := organization.NewOrganizationServiceClient(m.cli.Conn())
 	return client.GetDescendants(ctx, in, opts...)
 }
+
+// MoveOrganization 移动组织节点到新的父节点下
+func (m *defaultOrganizationService) MoveOrganization(ctx context.Context, in *MoveOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	client := organization.NewOrganizationServiceClient(m.cli.Conn())
+	return client.MoveOrganization(ctx, in, opts...)
+}
+
+// DisableOrganization 禁用组织节点
+func (m *defaultOrganizationService) DisableOrganization(ctx context.Context, in *DisableOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	client := organization.NewOrganizationServiceClient(m.cli.Conn())
+	return client.DisableOrganization(ctx, in, opts...)
+}
+
+// EnableOrganization 启用组织节点
+func (m *defaultOrganizationService) EnableOrganization(ctx context.Context, in *EnableOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	client := organization.NewOrganizationServiceClient(m.cli.Conn())
+	return client.EnableOrganization(ctx, in, opts...)
+}
+
+// RestoreOrganization 恢复已删除的组织节点
+func (m *defaultOrganizationService) RestoreOrganization(ctx context.Context, in *RestoreOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	client := organization.NewOrganizationServiceClient(m.cli.Conn())
+	return client.RestoreOrganization(ctx, in, opts...)
+}
+
+// GetOrganizationByCode 按组织编码获取组织节点
+func (m *defaultOrganizationService) GetOrganizationByCode(ctx context.Context, in *GetOrganizationByCodeRequest, opts ...grpc.CallOption) (*Organization, error) {
+	client := organization.NewOrganizationServiceClient(m.cli.Conn())
+	return client.GetOrganizationByCode(ctx, in, opts...)
+}
+
+// BatchGetOrganizationsByCode 按组织编码批量获取组织节点
+func (m *defaultOrganizationService) BatchGetOrganizationsByCode(ctx context.Context, in *BatchGetOrganizationsByCodeRequest, opts ...grpc.CallOption) (*BatchGetOrganizationsByCodeResponse, error) {
+	client := organization.NewOrganizationServiceClient(m.cli.Conn())
+	return client.BatchGetOrganizationsByCode(ctx, in, opts...)
+}
+
+// ReorderChildren 按给定顺序重排子节点
+func (m *defaultOrganizationService) ReorderChildren(ctx context.Context, in *ReorderChildrenRequest, opts ...grpc.CallOption) (*ReorderChildrenResponse, error) {
+	client := organization.NewOrganizationServiceClient(m.cli.Conn())
+	return client.ReorderChildren(ctx, in, opts...)
+}
+
+// ResolveDataScope ResolveDataScope 解析数据权限范围，返回可访问的组织 ID
+func (m *defaultOrganizationService) ResolveDataScope(ctx context.Context, in *ResolveDataScopeRequest, opts ...grpc.CallOption) (*ResolveDataScopeResponse, error) {
+	client := organization.NewOrganizationServiceClient(m.cli.Conn())
+	return client.ResolveDataScope(ctx, in, opts...)
+}
+
+// WatchOrganizations WatchOrganizations 订阅组织树变更，先推送子树快照，再按版本顺序推送变更事件
+func (m *defaultOrganizationService) WatchOrganizations(ctx context.Context, in *WatchOrganizationsRequest, opts ...grpc.CallOption) (organization.OrganizationService_WatchOrganizationsClient, error) {
+	client := organization.NewOrganizationServiceClient(m.cli.Conn())
+	return client.WatchOrganizations(ctx, in, opts...)
+}
@@ -12,6 +12,12 @@ CREATE TABLE org.organizations
     updated_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
     disabled_at TIMESTAMPTZ,
     deleted_at  TIMESTAMPTZ,
+    name_key    TEXT         NOT NULL,
+    path        TEXT         NOT NULL DEFAULT '',
+    org_type    VARCHAR(32)  NOT NULL DEFAULT 'department' CONSTRAINT chk_org_type CHECK (org_type IN ('group', 'company', 'division', 'department', 'team')),
+    code        VARCHAR(64)  NOT NULL CHECK (code ~ '^[A-Za-z0-9][A-Za-z0-9_.-]*$'),
+    attributes  JSONB        NOT NULL DEFAULT '{}'::jsonb CHECK (jsonb_typeof(attributes) = 'object'),
+    sort_order  INT          NOT NULL DEFAULT 0,
     
     -- 添加约束确保逻辑删除的数据不能被禁用
     CONSTRAINT chk_deleted_not_disabled CHECK (
@@ -48,6 +54,26 @@ CREATE INDEX idx_org_name ON org.organizations (name) WHERE deleted_at IS NULL;
 CREATE INDEX idx_org_created_at ON org.organizations (created_at);
 CREATE INDEX idx_org_updated_at ON org.organizations (updated_at);
 
+-- 同级名称唯一（按规范化名称，根节点 parent_id 为 NULL 视为同一组）
+CREATE UNIQUE INDEX uk_org_sibling_name ON org.organizations (COALESCE(parent_id, 0), name_key)
+    WHERE deleted_at IS NULL;
+
+-- 组织编码全局唯一（含已删除组织，保证恢复时不冲突）
+CREATE UNIQUE INDEX uk_org_code ON org.organizations (code);
+
+-- 编码自动生成使用的序列，见服务配置 Code.Pattern
+CREATE SEQUENCE org.organization_code_seq;
+
+-- 扩展属性按键值过滤（attributes @> ...）
+CREATE INDEX idx_org_attributes ON org.organizations USING GIN (attributes jsonb_path_ops);
+
+-- 物化路径前缀查询（子树）及按层级查询
+CREATE INDEX idx_org_path ON org.organizations (path text_pattern_ops);
+CREATE INDEX idx_org_path_depth ON org.organizations ((LENGTH(path) - LENGTH(REPLACE(path, '/', ''))));
+
+-- 按兄弟顺序查询子节点
+CREATE INDEX idx_org_parent_sort ON org.organizations (parent_id, sort_order) WHERE deleted_at IS NULL;
+
 -- 复合索引用于常见查询场景
 CREATE INDEX idx_org_parent_active ON org.organizations (parent_id, id) 
     WHERE deleted_at IS NULL AND disabled_at IS NULL;
@@ -56,6 +82,397 @@ CREATE INDEX idx_org_parent_active ON org.organizations (parent_id, id)
 CREATE INDEX idx_org_deleted_at ON org.organizations (deleted_at) WHERE deleted_at IS NOT NULL;
 CREATE INDEX idx_org_disabled_at ON org.organizations (disabled_at) WHERE disabled_at IS NOT NULL;
 
+-- =========================================================
+-- 2. 组织闭包表（祖先-后代关系），由服务端在插入、移动、删除时维护
+-- =========================================================
+CREATE TABLE org.organization_closure
+(
+    ancestor_id   BIGINT NOT NULL REFERENCES org.organizations (id) ON DELETE CASCADE,
+    descendant_id BIGINT NOT NULL REFERENCES org.organizations (id) ON DELETE CASCADE,
+    depth         INT    NOT NULL CHECK (depth >= 0),
+    PRIMARY KEY (ancestor_id, descendant_id)
+);
+
+-- 按后代查询祖先链
+CREATE INDEX idx_org_closure_descendant ON org.organization_closure (descendant_id, depth);
+
+-- =========================================================
+-- 3. 组织成员（用户与组织的归属关系），组织软删除时由服务端结束其成员关系
+-- =========================================================
+CREATE TABLE org.memberships
+(
+    id              BIGSERIAL PRIMARY KEY,
+    organization_id BIGINT      NOT NULL REFERENCES org.organizations (id) ON DELETE CASCADE,
+    user_id         BIGINT      NOT NULL,
+    is_primary      BOOLEAN     NOT NULL DEFAULT FALSE,
+    joined_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    left_at         TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_membership_left CHECK (left_at IS NULL OR left_at >= joined_at)
+);
+
+CREATE TRIGGER trigger_update_memberships_updated_at
+    BEFORE UPDATE ON org.memberships
+    FOR EACH ROW
+    EXECUTE FUNCTION org.update_updated_at_column();
+
+-- 同一用户在同一组织只能有一条未离开的成员关系；每个用户至多一个主组织
+CREATE UNIQUE INDEX uk_membership_active ON org.memberships (organization_id, user_id) WHERE left_at IS NULL;
+CREATE UNIQUE INDEX uk_membership_primary ON org.memberships (user_id) WHERE is_primary AND left_at IS NULL;
+CREATE INDEX idx_membership_user ON org.memberships (user_id, joined_at);
+CREATE INDEX idx_membership_org_joined ON org.memberships (organization_id, joined_at) WHERE left_at IS NULL;
+
+-- =========================================================
+-- 4. 组织职位（负责人、副职及自定义职位），user_id 为 NULL 表示空缺
+-- =========================================================
+CREATE TABLE org.positions
+(
+    id              BIGSERIAL PRIMARY KEY,
+    organization_id BIGINT       NOT NULL REFERENCES org.organizations (id) ON DELETE CASCADE,
+    kind            VARCHAR(16)  NOT NULL CHECK (kind IN ('head', 'deputy', 'custom')),
+    title           VARCHAR(120) NOT NULL CHECK (LENGTH(TRIM(title)) > 0),
+    user_id         BIGINT,
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trigger_update_positions_updated_at
+    BEFORE UPDATE ON org.positions
+    FOR EACH ROW
+    EXECUTE FUNCTION org.update_updated_at_column();
+
+-- 每个组织至多一个负责人职位
+CREATE UNIQUE INDEX uk_position_head ON org.positions (organization_id) WHERE kind = 'head';
+CREATE INDEX idx_position_org ON org.positions (organization_id);
+CREATE INDEX idx_position_user ON org.positions (user_id) WHERE user_id IS NOT NULL;
+
+-- =========================================================
+-- 5. 组织管理授权（委派管理员），授权随组织物理删除而删除
+-- =========================================================
+CREATE TABLE org.admin_grants
+(
+    id              BIGSERIAL PRIMARY KEY,
+    user_id         BIGINT      NOT NULL,
+    organization_id BIGINT      NOT NULL REFERENCES org.organizations (id) ON DELETE CASCADE,
+    permissions     TEXT[]      NOT NULL,
+    inherit         BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at      TIMESTAMPTZ,
+
+    CONSTRAINT chk_admin_grant_permissions CHECK (
+        CARDINALITY(permissions) > 0 AND
+        permissions <@ ARRAY ['create', 'update', 'delete', 'move', 'disable']::TEXT[])
+);
+
+CREATE TRIGGER trigger_update_admin_grants_updated_at
+    BEFORE UPDATE ON org.admin_grants
+    FOR EACH ROW
+    EXECUTE FUNCTION org.update_updated_at_column();
+
+-- 每个用户在每个组织上至多一条授权
+CREATE UNIQUE INDEX uk_admin_grant ON org.admin_grants (user_id, organization_id);
+CREATE INDEX idx_admin_grant_org ON org.admin_grants (organization_id);
+
+-- =========================================================
+-- 6. 组织变更事件发件箱（transactional outbox），由服务端的投递协程发布
+-- =========================================================
+CREATE TABLE org.outbox
+(
+    id                 BIGSERIAL PRIMARY KEY,
+    event_type         VARCHAR(16) NOT NULL,
+    organization_id    BIGINT      NOT NULL,
+    previous_parent_id BIGINT,
+    payload            JSONB       NOT NULL,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at       TIMESTAMPTZ,
+    attempts           INTEGER     NOT NULL DEFAULT 0,
+    next_attempt_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error         TEXT,
+    dead_at            TIMESTAMPTZ
+);
+
+-- 待投递事件按组织保序取出；已投递及转为死信的事件按时间清理
+CREATE INDEX idx_outbox_pending ON org.outbox (organization_id, id) WHERE published_at IS NULL AND dead_at IS NULL;
+CREATE INDEX idx_outbox_published ON org.outbox (published_at) WHERE published_at IS NOT NULL;
+CREATE INDEX idx_outbox_dead ON org.outbox (dead_at) WHERE dead_at IS NOT NULL;
+
+-- =========================================================
+-- 7. Webhook 订阅、投递及投递尝试记录，由服务端的投递协程推送
+-- =========================================================
+CREATE TABLE org.webhooks
+(
+    id                   BIGSERIAL PRIMARY KEY,
+    url                  TEXT         NOT NULL,
+    event_types          TEXT[]       NOT NULL DEFAULT '{}',
+    root_organization_id BIGINT,
+    secret               TEXT         NOT NULL,
+    description          VARCHAR(255) NOT NULL DEFAULT '',
+    created_at           TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trigger_update_webhooks_updated_at
+    BEFORE UPDATE ON org.webhooks
+    FOR EACH ROW
+    EXECUTE FUNCTION org.update_updated_at_column();
+
+CREATE TABLE org.webhook_deliveries
+(
+    id              BIGSERIAL PRIMARY KEY,
+    webhook_id      BIGINT      NOT NULL REFERENCES org.webhooks (id) ON DELETE CASCADE,
+    event_id        BIGINT      NOT NULL,
+    event_type      VARCHAR(16) NOT NULL,
+    payload         TEXT        NOT NULL,
+    status          VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error      TEXT,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    delivered_at    TIMESTAMPTZ,
+
+    CONSTRAINT chk_webhook_delivery_status CHECK (status IN ('pending', 'delivered', 'dead'))
+);
+
+CREATE TRIGGER trigger_update_webhook_deliveries_updated_at
+    BEFORE UPDATE ON org.webhook_deliveries
+    FOR EACH ROW
+    EXECUTE FUNCTION org.update_updated_at_column();
+
+-- 同一事件对每个订阅至多一条投递，发件箱重复发布时不重复投递
+CREATE UNIQUE INDEX uk_webhook_delivery_event ON org.webhook_deliveries (webhook_id, event_id);
+CREATE INDEX idx_webhook_delivery_due ON org.webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+CREATE TABLE org.webhook_attempts
+(
+    id          BIGSERIAL PRIMARY KEY,
+    delivery_id BIGINT      NOT NULL REFERENCES org.webhook_deliveries (id) ON DELETE CASCADE,
+    webhook_id  BIGINT      NOT NULL REFERENCES org.webhooks (id) ON DELETE CASCADE,
+    attempt     INTEGER     NOT NULL,
+    status_code INTEGER     NOT NULL DEFAULT 0,
+    error       TEXT,
+    duration_ms BIGINT      NOT NULL DEFAULT 0,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- 按订阅倒序查询投递记录
+CREATE INDEX idx_webhook_attempt_webhook ON org.webhook_attempts (webhook_id, id);
+CREATE INDEX idx_webhook_attempt_delivery ON org.webhook_attempts (delivery_id);
+
+-- =========================================================
+-- 8. 审计日志，由触发器在组织及其成员、职位、管理授权、Webhook 订阅变更的事务中写入
+-- =========================================================
+CREATE TABLE org.audit_log
+(
+    id              BIGSERIAL PRIMARY KEY,
+    actor           VARCHAR(128) NOT NULL DEFAULT '',
+    action          VARCHAR(64)  NOT NULL DEFAULT '',
+    organization_id BIGINT       NOT NULL,
+    path_ids        BIGINT[]     NOT NULL DEFAULT '{}',
+    before_data     JSONB,
+    after_data      JSONB,
+    request_id      VARCHAR(128) NOT NULL DEFAULT '',
+    transaction_id  BIGINT       NOT NULL DEFAULT txid_current(),
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    entity          VARCHAR(32)  NOT NULL DEFAULT 'organization',
+    entity_id       BIGINT       NOT NULL
+);
+
+-- 按组织、子树、操作人查询，均按 ID 倒序翻页
+CREATE INDEX idx_audit_log_organization ON org.audit_log (organization_id, id);
+CREATE INDEX idx_audit_log_path_ids ON org.audit_log USING GIN (path_ids);
+CREATE INDEX idx_audit_log_actor ON org.audit_log (actor, id);
+CREATE INDEX idx_audit_log_created_at ON org.audit_log (created_at);
+-- 触发器按事务合并同一记录的多次变更
+CREATE INDEX idx_audit_log_transaction ON org.audit_log (transaction_id, entity, entity_id);
+
+-- 物化路径中的组织 ID，如 /1/5/12/ 为 {1,5,12}
+CREATE OR REPLACE FUNCTION org.audit_path_ids(path TEXT)
+RETURNS BIGINT[] AS $$
+    SELECT COALESCE(string_to_array(trim(BOTH '/' FROM path), '/')::BIGINT[], '{}');
+$$ LANGUAGE sql IMMUTABLE;
+
+-- 记录组织变更：操作人、接口与请求 ID 由服务端在事务开始时通过 set_config 设置，直接修改数据库时为空
+-- 同一事务中对同一组织的多次变更（如创建后写入物化路径）合并为一条，before_data 为事务开始前的状态；
+-- path_ids 包含变更前后两个位置的组织 ID，移出子树的组织在原子树中同样可查
+CREATE OR REPLACE FUNCTION org.audit_organizations()
+RETURNS TRIGGER AS $$
+DECLARE
+    old_row JSONB;
+    new_row JSONB;
+    org_id  BIGINT;
+    ids     BIGINT[];
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        old_row := to_jsonb(OLD);
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        new_row := to_jsonb(NEW);
+    END IF;
+    org_id := (COALESCE(new_row, old_row) ->> 'id')::BIGINT;
+    ids := org.audit_path_ids(old_row ->> 'path') || org.audit_path_ids(new_row ->> 'path');
+
+    UPDATE org.audit_log
+    SET after_data = new_row,
+        path_ids   = ARRAY(SELECT DISTINCT unnest(path_ids || ids))
+    WHERE transaction_id = txid_current()
+      AND entity = 'organization'
+      AND entity_id = org_id;
+    IF FOUND THEN
+        RETURN NULL;
+    END IF;
+
+    -- 仅 updated_at 变化的更新不记录
+    IF TG_OP = 'UPDATE' AND old_row - 'updated_at' = new_row - 'updated_at' THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO org.audit_log (entity, entity_id, actor, action, organization_id, path_ids, before_data, after_data, request_id)
+    VALUES ('organization',
+            org_id,
+            COALESCE(current_setting('org.audit_actor', true), ''),
+            COALESCE(current_setting('org.audit_action', true), ''),
+            org_id,
+            ARRAY(SELECT DISTINCT unnest(ids)),
+            old_row,
+            new_row,
+            COALESCE(current_setting('org.audit_request_id', true), ''));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- 记录组织下属数据的变更，TG_ARGV[0] 为记录类型，TG_ARGV[1] 为所属组织的列名：
+-- organization_id 为所属组织（不限子树的 Webhook 订阅为 0），path_ids 为所属组织当前物化路径中的组织 ID；
+-- 同一事务中对同一记录的多次变更合并为一条；Webhook 订阅的签名密钥不记录
+CREATE OR REPLACE FUNCTION org.audit_related()
+RETURNS TRIGGER AS $$
+DECLARE
+    old_row JSONB;
+    new_row JSONB;
+    row_id  BIGINT;
+    org_id  BIGINT;
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        old_row := to_jsonb(OLD) - 'secret';
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        new_row := to_jsonb(NEW) - 'secret';
+    END IF;
+    row_id := (COALESCE(new_row, old_row) ->> 'id')::BIGINT;
+    org_id := COALESCE((COALESCE(new_row, old_row) ->> TG_ARGV[1])::BIGINT, 0);
+
+    UPDATE org.audit_log
+    SET after_data = new_row
+    WHERE transaction_id = txid_current()
+      AND entity = TG_ARGV[0]
+      AND entity_id = row_id;
+    IF FOUND THEN
+        RETURN NULL;
+    END IF;
+
+    IF TG_OP = 'UPDATE' AND old_row - 'updated_at' = new_row - 'updated_at' THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO org.audit_log (entity, entity_id, actor, action, organization_id, path_ids, before_data, after_data, request_id)
+    VALUES (TG_ARGV[0],
+            row_id,
+            COALESCE(current_setting('org.audit_actor', true), ''),
+            COALESCE(current_setting('org.audit_action', true), ''),
+            org_id,
+            COALESCE((SELECT org.audit_path_ids(o.path) FROM org.organizations o WHERE o.id = org_id), '{}'),
+            old_row,
+            new_row,
+            COALESCE(current_setting('org.audit_request_id', true), ''));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_audit_organizations
+    AFTER INSERT OR UPDATE OR DELETE ON org.organizations
+    FOR EACH ROW
+    EXECUTE FUNCTION org.audit_organizations();
+
+CREATE TRIGGER trigger_audit_memberships
+    AFTER INSERT OR UPDATE OR DELETE ON org.memberships
+    FOR EACH ROW
+    EXECUTE FUNCTION org.audit_related('membership', 'organization_id');
+
+CREATE TRIGGER trigger_audit_positions
+    AFTER INSERT OR UPDATE OR DELETE ON org.positions
+    FOR EACH ROW
+    EXECUTE FUNCTION org.audit_related('position', 'organization_id');
+
+CREATE TRIGGER trigger_audit_admin_grants
+    AFTER INSERT OR UPDATE OR DELETE ON org.admin_grants
+    FOR EACH ROW
+    EXECUTE FUNCTION org.audit_related('admin_grant', 'organization_id');
+
+CREATE TRIGGER trigger_audit_webhooks
+    AFTER INSERT OR UPDATE OR DELETE ON org.webhooks
+    FOR EACH ROW
+    EXECUTE FUNCTION org.audit_related('webhook', 'root_organization_id');
+
+-- =========================================================
+-- 9. 组织历史版本，由触发器维护，用于按时刻查询组织树
+-- =========================================================
+-- 列与 org.organizations 一致；org.organizations 新增列时需同时在此表新增，并加入触发器的列清单
+CREATE TABLE org.organization_history
+(
+    LIKE org.organizations,
+    valid_from     TIMESTAMPTZ NOT NULL,
+    valid_to       TIMESTAMPTZ,
+    transaction_id BIGINT      NOT NULL DEFAULT txid_current(),
+
+    PRIMARY KEY (id, valid_from),
+    CONSTRAINT chk_org_history_valid CHECK (valid_to IS NULL OR valid_to > valid_from)
+);
+
+-- 按时刻查询各版本，及按父节点、物化路径查询历史子树
+CREATE INDEX idx_org_history_valid ON org.organization_history (valid_from, valid_to);
+CREATE INDEX idx_org_history_parent ON org.organization_history (parent_id);
+CREATE INDEX idx_org_history_path ON org.organization_history (path text_pattern_ops);
+
+-- 维护组织的历史版本：每次变更结束当前版本（valid_to）并写入新版本，物理删除只结束当前版本
+-- 版本以事务开始时间为界，同一事务中的多次变更只保留最终状态；仅 updated_at 变化的更新不产生新版本
+-- 事务开始早于当前版本的生效时间（并发事务先提交）时，以当前版本的生效时间之后作为边界，保证各版本首尾相接、不重叠
+CREATE OR REPLACE FUNCTION org.record_organization_history()
+RETURNS TRIGGER AS $$
+DECLARE
+    stamp TIMESTAMPTZ;
+BEGIN
+    IF TG_OP = 'UPDATE' AND to_jsonb(OLD) - 'updated_at' = to_jsonb(NEW) - 'updated_at' THEN
+        RETURN NULL;
+    END IF;
+    IF TG_OP <> 'INSERT' THEN
+        -- 同一事务中先前写入的版本由本次变更取代，沿用其生效时间
+        DELETE FROM org.organization_history
+        WHERE id = OLD.id AND valid_to IS NULL AND transaction_id = txid_current()
+        RETURNING valid_from INTO stamp;
+        IF stamp IS NULL THEN
+            UPDATE org.organization_history SET valid_to = GREATEST(NOW(), valid_from + INTERVAL '1 microsecond')
+            WHERE id = OLD.id AND valid_to IS NULL
+            RETURNING valid_to INTO stamp;
+        END IF;
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        INSERT INTO org.organization_history (id, parent_id, name, created_at, updated_at, disabled_at, deleted_at, name_key,
+                                              path, org_type, code, attributes, sort_order, valid_from, valid_to)
+        VALUES (NEW.id, NEW.parent_id, NEW.name, NEW.created_at, NEW.updated_at, NEW.disabled_at, NEW.deleted_at, NEW.name_key,
+                NEW.path, NEW.org_type, NEW.code, NEW.attributes, NEW.sort_order, COALESCE(stamp, NOW()), NULL);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_record_organization_history
+    AFTER INSERT OR UPDATE OR DELETE ON org.organizations
+    FOR EACH ROW
+    EXECUTE FUNCTION org.record_organization_history();
+
 -- 添加注释
 COMMENT ON TABLE org.organizations IS '组织机构表，支持树形结构';
 COMMENT ON COLUMN org.organizations.id IS '主键ID';
@@ -64,4 +481,86 @@ COMMENT ON COLUMN org.organizations.name IS '组织名称，不能为空或纯
 COMMENT ON COLUMN org.organizations.created_at IS '创建时间';
 COMMENT ON COLUMN org.organizations.updated_at IS '更新时间，通过触发器自动维护';
 COMMENT ON COLUMN org.organizations.disabled_at IS '禁用时间，NULL表示未禁用';
-COMMENT ON COLUMN org.organizations.deleted_at IS '软删除时间，NULL表示未删除';
\ No newline at end of file
+COMMENT ON COLUMN org.organizations.deleted_at IS '软删除时间，NULL表示未删除';
+COMMENT ON COLUMN org.organizations.name_key IS '规范化名称，用于同级重名校验，由服务端按配置生成；修改规范化配置后需执行 organization -rebuild-name-keys';
+COMMENT ON COLUMN org.organizations.org_type IS '组织类型：group/company/division/department/team，层级规则见服务配置 OrgType';
+COMMENT ON COLUMN org.organizations.code IS '组织编码，全局唯一，供外部系统引用；默认创建后不可修改';
+COMMENT ON COLUMN org.organizations.attributes IS '扩展属性，如成本中心、地址、电话等，属性定义见服务配置 Attributes';
+COMMENT ON COLUMN org.organizations.sort_order IS '兄弟节点间的显示顺序，从 0 开始，越小越靠前';
+COMMENT ON COLUMN org.organizations.path IS '物化路径，从根到自身的ID以/分隔，如 /1/5/12/，由服务端在创建和移动时维护';
+
+COMMENT ON TABLE org.organization_closure IS '组织闭包表，保存所有祖先与后代关系（含自身）';
+COMMENT ON COLUMN org.organization_closure.ancestor_id IS '祖先组织ID';
+COMMENT ON COLUMN org.organization_closure.descendant_id IS '后代组织ID';
+COMMENT ON COLUMN org.organization_closure.depth IS '祖先到后代的距离，0 表示自身';
+
+COMMENT ON TABLE org.memberships IS '组织成员表，记录用户在组织中的归属及加入、离开时间';
+COMMENT ON COLUMN org.memberships.organization_id IS '组织ID';
+COMMENT ON COLUMN org.memberships.user_id IS '用户ID，由用户服务维护';
+COMMENT ON COLUMN org.memberships.is_primary IS '是否为该用户的主组织';
+COMMENT ON COLUMN org.memberships.joined_at IS '加入时间';
+COMMENT ON COLUMN org.memberships.left_at IS '离开时间，NULL表示仍在组织中；组织被软删除时自动填写';
+
+COMMENT ON TABLE org.positions IS '组织职位表，记录各组织的负责人、副职等职位及任职用户';
+COMMENT ON COLUMN org.positions.organization_id IS '组织ID';
+COMMENT ON COLUMN org.positions.kind IS '职位类型：head 负责人/deputy 副职/custom 自定义';
+COMMENT ON COLUMN org.positions.title IS '职位名称';
+COMMENT ON COLUMN org.positions.user_id IS '任职用户ID，NULL表示空缺';
+
+COMMENT ON TABLE org.admin_grants IS '组织管理授权表，委派用户管理指定组织（及其下级组织）';
+COMMENT ON COLUMN org.admin_grants.user_id IS '被授权的用户ID，对应访问令牌的 sub';
+COMMENT ON COLUMN org.admin_grants.organization_id IS '授权的组织ID';
+COMMENT ON COLUMN org.admin_grants.permissions IS '授予的权限：create/update/delete/move/disable';
+COMMENT ON COLUMN org.admin_grants.inherit IS '是否同时管理全部下级组织';
+COMMENT ON COLUMN org.admin_grants.expires_at IS '过期时间，过期后授权不再生效；NULL 表示长期有效';
+
+COMMENT ON TABLE org.outbox IS '组织变更事件发件箱，与组织变更在同一事务中写入，由服务端投递后标记';
+COMMENT ON COLUMN org.outbox.event_type IS '变更类型：created/updated/moved/disabled/enabled/deleted/restored/reordered/purged';
+COMMENT ON COLUMN org.outbox.organization_id IS '组织ID；不设外键，物理删除后事件仍保留';
+COMMENT ON COLUMN org.outbox.previous_parent_id IS '移动前的父级组织ID，仅 moved 事件，NULL 表示原为根节点';
+COMMENT ON COLUMN org.outbox.payload IS '变更后的组织行快照；purged 事件为删除前的快照';
+COMMENT ON COLUMN org.outbox.published_at IS '投递成功时间，NULL表示待投递';
+COMMENT ON COLUMN org.outbox.attempts IS '失败的投递次数';
+COMMENT ON COLUMN org.outbox.next_attempt_at IS '下次投递时间，失败后按退避推迟，领取后推迟一个租期';
+COMMENT ON COLUMN org.outbox.last_error IS '最近一次投递失败的原因';
+COMMENT ON COLUMN org.outbox.dead_at IS '投递失败次数达到上限、转为死信的时间，NULL表示未转为死信；死信不再投递，也不再阻塞同一组织的后续事件';
+
+COMMENT ON TABLE org.webhooks IS 'Webhook 订阅表，组织变更事件按订阅的过滤条件以 HTTP POST 推送';
+COMMENT ON COLUMN org.webhooks.url IS '推送地址';
+COMMENT ON COLUMN org.webhooks.event_types IS '订阅的变更类型，空数组表示全部';
+COMMENT ON COLUMN org.webhooks.root_organization_id IS '仅订阅该组织子树内的变更，NULL表示全部；不设外键，组织物理删除后订阅仍保留';
+COMMENT ON COLUMN org.webhooks.secret IS '请求签名（HMAC-SHA256）使用的密钥';
+COMMENT ON COLUMN org.webhooks.description IS '订阅说明';
+
+COMMENT ON TABLE org.webhook_deliveries IS 'Webhook 投递表，每个事件对每个匹配的订阅一条';
+COMMENT ON COLUMN org.webhook_deliveries.event_id IS '发件箱事件ID（org.outbox.id）';
+COMMENT ON COLUMN org.webhook_deliveries.event_type IS '变更类型';
+COMMENT ON COLUMN org.webhook_deliveries.payload IS '推送的请求体，即 JSON 格式的 OrganizationChanged';
+COMMENT ON COLUMN org.webhook_deliveries.status IS '投递状态：pending 待投递/delivered 已投递/dead 重试耗尽';
+COMMENT ON COLUMN org.webhook_deliveries.attempts IS '已尝试的次数';
+COMMENT ON COLUMN org.webhook_deliveries.next_attempt_at IS '下次投递时间，失败后按退避推迟';
+COMMENT ON COLUMN org.webhook_deliveries.last_error IS '最近一次投递失败的原因';
+COMMENT ON COLUMN org.webhook_deliveries.delivered_at IS '投递成功时间';
+
+COMMENT ON TABLE org.webhook_attempts IS 'Webhook 投递尝试记录';
+COMMENT ON COLUMN org.webhook_attempts.attempt IS '第几次尝试，从 1 开始';
+COMMENT ON COLUMN org.webhook_attempts.status_code IS '响应状态码，0表示未收到响应';
+COMMENT ON COLUMN org.webhook_attempts.error IS '失败原因，NULL表示成功';
+COMMENT ON COLUMN org.webhook_attempts.duration_ms IS '请求耗时（毫秒）';
+
+COMMENT ON TABLE org.audit_log IS '审计日志，记录组织及其成员、职位、管理授权、Webhook 订阅的变更，由触发器写入，同一事务中对同一记录的多次变更合并为一条';
+COMMENT ON COLUMN org.audit_log.actor IS '操作人，取自调用方令牌的 sub 或请求元数据 x-actor；直接修改数据库时为空';
+COMMENT ON COLUMN org.audit_log.action IS '调用的接口，如 UpdateOrganization；直接修改数据库时为空';
+COMMENT ON COLUMN org.audit_log.organization_id IS '变更的组织ID或变更记录所属的组织ID（不限子树的 Webhook 订阅为0），组织物理删除后保留';
+COMMENT ON COLUMN org.audit_log.path_ids IS '变更前后物化路径中的组织ID，用于按子树查询';
+COMMENT ON COLUMN org.audit_log.before_data IS '变更前的记录数据，创建时为 NULL';
+COMMENT ON COLUMN org.audit_log.after_data IS '变更后的记录数据，删除时为 NULL';
+COMMENT ON COLUMN org.audit_log.request_id IS '请求ID，取自请求元数据 x-request-id，缺省为链路追踪ID';
+COMMENT ON COLUMN org.audit_log.transaction_id IS '写入的事务ID，用于合并同一事务中的变更';
+COMMENT ON COLUMN org.audit_log.entity IS '变更的记录类型：organization/membership/position/admin_grant/webhook';
+COMMENT ON COLUMN org.audit_log.entity_id IS '变更的记录ID，组织变更时与 organization_id 相同';
+
+COMMENT ON TABLE org.organization_history IS '组织历史版本，每行为组织在 [valid_from, valid_to) 期间的状态，由触发器维护';
+COMMENT ON COLUMN org.organization_history.valid_from IS '版本生效时间，即产生该版本的事务开始时间，不早于上一版本的生效时间';
+COMMENT ON COLUMN org.organization_history.valid_to IS '版本失效时间，NULL表示当前版本；组织物理删除后不再有当前版本';
+COMMENT ON COLUMN org.organization_history.transaction_id IS '写入该版本的事务ID，同一事务中的多次变更合并为一个版本';
@@ -0,0 +1,22 @@
+-- =========================================================
+-- 001 同级名称唯一约束
+-- 为已有数据库添加 name_key 列及部分唯一索引
+-- =========================================================
+BEGIN;
+
+ALTER TABLE org.organizations ADD COLUMN name_key TEXT;
+
+-- 按默认规范化规则近似回填（去除首尾空白、全角转半角、忽略大小写）；NFKC 与 LOWER 的结果与服务端的规则
+-- （Unicode 空白、宽度折叠、大小写折叠）及数据库的区域设置有关，迁移后需执行 organization -rebuild-name-keys 按服务端规则重新生成
+-- 规范化后的长度可能超过名称本身（如 NFKC 展开合字），因此 name_key 不限制长度
+UPDATE org.organizations SET name_key = LOWER(NORMALIZE(TRIM(name), NFKC));
+
+ALTER TABLE org.organizations ALTER COLUMN name_key SET NOT NULL;
+
+-- 若此处失败，说明已有同级重名数据，需先人工处理
+CREATE UNIQUE INDEX uk_org_sibling_name ON org.organizations (COALESCE(parent_id, 0), name_key)
+    WHERE deleted_at IS NULL;
+
+COMMENT ON COLUMN org.organizations.name_key IS '规范化名称，用于同级重名校验，由服务端按配置生成';
+
+COMMIT;
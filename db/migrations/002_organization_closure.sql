@@ -0,0 +1,33 @@
+-- =========================================================
+-- 002 组织闭包表
+-- 创建 organization_closure 并根据 parent_id 回填，
+-- 之后也可通过 organization -rebuild-closure 重新生成
+-- =========================================================
+BEGIN;
+
+CREATE TABLE org.organization_closure
+(
+    ancestor_id   BIGINT NOT NULL REFERENCES org.organizations (id) ON DELETE CASCADE,
+    descendant_id BIGINT NOT NULL REFERENCES org.organizations (id) ON DELETE CASCADE,
+    depth         INT    NOT NULL CHECK (depth >= 0),
+    PRIMARY KEY (ancestor_id, descendant_id)
+);
+
+CREATE INDEX idx_org_closure_descendant ON org.organization_closure (descendant_id, depth);
+
+INSERT INTO org.organization_closure (ancestor_id, descendant_id, depth)
+WITH RECURSIVE c AS (
+    SELECT id AS ancestor_id, id AS descendant_id, 0 AS depth FROM org.organizations
+    UNION ALL
+    SELECT c.ancestor_id, o.id, c.depth + 1
+    FROM c JOIN org.organizations o ON o.parent_id = c.descendant_id
+    WHERE c.depth < 256
+)
+SELECT ancestor_id, descendant_id, depth FROM c;
+
+COMMENT ON TABLE org.organization_closure IS '组织闭包表，保存所有祖先与后代关系（含自身）';
+COMMENT ON COLUMN org.organization_closure.ancestor_id IS '祖先组织ID';
+COMMENT ON COLUMN org.organization_closure.descendant_id IS '后代组织ID';
+COMMENT ON COLUMN org.organization_closure.depth IS '祖先到后代的距离，0 表示自身';
+
+COMMIT;
@@ -0,0 +1,25 @@
+-- =========================================================
+-- 003 物化路径
+-- 添加 path 列并根据 parent_id 回填，如 /1/5/12/
+-- =========================================================
+BEGIN;
+
+ALTER TABLE org.organizations ADD COLUMN path TEXT NOT NULL DEFAULT '';
+
+WITH RECURSIVE p AS (
+    SELECT id, '/' || id || '/' AS path, 0 AS depth
+    FROM org.organizations
+    WHERE parent_id IS NULL
+    UNION ALL
+    SELECT o.id, p.path || o.id || '/', p.depth + 1
+    FROM p JOIN org.organizations o ON o.parent_id = p.id
+    WHERE p.depth < 256
+)
+UPDATE org.organizations o SET path = p.path FROM p WHERE o.id = p.id;
+
+CREATE INDEX idx_org_path ON org.organizations (path text_pattern_ops);
+CREATE INDEX idx_org_path_depth ON org.organizations ((LENGTH(path) - LENGTH(REPLACE(path, '/', ''))));
+
+COMMENT ON COLUMN org.organizations.path IS '物化路径，从根到自身的ID以/分隔，如 /1/5/12/，由服务端在创建和移动时维护';
+
+COMMIT;
@@ -0,0 +1,12 @@
+-- =========================================================
+-- 004 组织类型
+-- 已有组织默认视为 department，可按需手工调整
+-- =========================================================
+BEGIN;
+
+ALTER TABLE org.organizations ADD COLUMN org_type VARCHAR(32) NOT NULL DEFAULT 'department'
+    CONSTRAINT chk_org_type CHECK (org_type IN ('group', 'company', 'division', 'department', 'team'));
+
+COMMENT ON COLUMN org.organizations.org_type IS '组织类型：group/company/division/department/team，层级规则见服务配置 OrgType';
+
+COMMIT;
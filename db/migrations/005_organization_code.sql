@@ -0,0 +1,20 @@
+-- =========================================================
+-- 005 组织编码
+-- 已有组织回填为 ORG-<id>，上线后可按外部系统的编码手工调整
+-- =========================================================
+BEGIN;
+
+ALTER TABLE org.organizations ADD COLUMN code VARCHAR(64);
+
+UPDATE org.organizations SET code = 'ORG-' || id;
+
+ALTER TABLE org.organizations ALTER COLUMN code SET NOT NULL;
+ALTER TABLE org.organizations ADD CONSTRAINT organizations_code_check CHECK (code ~ '^[A-Za-z0-9][A-Za-z0-9_.-]*$');
+
+CREATE UNIQUE INDEX uk_org_code ON org.organizations (code);
+
+CREATE SEQUENCE org.organization_code_seq;
+
+COMMENT ON COLUMN org.organizations.code IS '组织编码，全局唯一，供外部系统引用；默认创建后不可修改';
+
+COMMIT;
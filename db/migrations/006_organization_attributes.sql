@@ -0,0 +1,14 @@
+-- =========================================================
+-- 006 扩展属性
+-- 添加 attributes 列及 GIN 索引，已有组织为空对象
+-- =========================================================
+BEGIN;
+
+ALTER TABLE org.organizations ADD COLUMN attributes JSONB NOT NULL DEFAULT '{}'::jsonb;
+ALTER TABLE org.organizations ADD CONSTRAINT organizations_attributes_check CHECK (jsonb_typeof(attributes) = 'object');
+
+CREATE INDEX idx_org_attributes ON org.organizations USING GIN (attributes jsonb_path_ops);
+
+COMMENT ON COLUMN org.organizations.attributes IS '扩展属性，如成本中心、地址、电话等，属性定义见服务配置 Attributes';
+
+COMMIT;
@@ -0,0 +1,20 @@
+-- =========================================================
+-- 007 兄弟节点排序
+-- 添加 sort_order 列，已有组织按同级创建顺序回填
+-- =========================================================
+BEGIN;
+
+ALTER TABLE org.organizations ADD COLUMN sort_order INT NOT NULL DEFAULT 0;
+
+UPDATE org.organizations o SET sort_order = s.rn
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY parent_id ORDER BY created_at, id) - 1 AS rn
+    FROM org.organizations
+) s
+WHERE o.id = s.id;
+
+CREATE INDEX idx_org_parent_sort ON org.organizations (parent_id, sort_order) WHERE deleted_at IS NULL;
+
+COMMENT ON COLUMN org.organizations.sort_order IS '兄弟节点间的显示顺序，从 0 开始，越小越靠前';
+
+COMMIT;
@@ -0,0 +1,39 @@
+-- =========================================================
+-- 008 组织成员
+-- 新增 org.memberships 表
+-- =========================================================
+BEGIN;
+
+CREATE TABLE org.memberships
+(
+    id              BIGSERIAL PRIMARY KEY,
+    organization_id BIGINT      NOT NULL REFERENCES org.organizations (id) ON DELETE CASCADE,
+    user_id         BIGINT      NOT NULL,
+    is_primary      BOOLEAN     NOT NULL DEFAULT FALSE,
+    joined_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    left_at         TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_membership_left CHECK (left_at IS NULL OR left_at >= joined_at)
+);
+
+CREATE TRIGGER trigger_update_memberships_updated_at
+    BEFORE UPDATE ON org.memberships
+    FOR EACH ROW
+    EXECUTE FUNCTION org.update_updated_at_column();
+
+-- 同一用户在同一组织只能有一条未离开的成员关系；每个用户至多一个主组织
+CREATE UNIQUE INDEX uk_membership_active ON org.memberships (organization_id, user_id) WHERE left_at IS NULL;
+CREATE UNIQUE INDEX uk_membership_primary ON org.memberships (user_id) WHERE is_primary AND left_at IS NULL;
+CREATE INDEX idx_membership_user ON org.memberships (user_id, joined_at);
+CREATE INDEX idx_membership_org_joined ON org.memberships (organization_id, joined_at) WHERE left_at IS NULL;
+
+COMMENT ON TABLE org.memberships IS '组织成员表，记录用户在组织中的归属及加入、离开时间';
+COMMENT ON COLUMN org.memberships.organization_id IS '组织ID';
+COMMENT ON COLUMN org.memberships.user_id IS '用户ID，由用户服务维护';
+COMMENT ON COLUMN org.memberships.is_primary IS '是否为该用户的主组织';
+COMMENT ON COLUMN org.memberships.joined_at IS '加入时间';
+COMMENT ON COLUMN org.memberships.left_at IS '离开时间，NULL表示仍在组织中；组织被软删除时自动填写';
+
+COMMIT;
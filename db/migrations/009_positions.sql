@@ -0,0 +1,34 @@
+-- =========================================================
+-- 009 组织职位
+-- 新增 org.positions 表
+-- =========================================================
+BEGIN;
+
+CREATE TABLE org.positions
+(
+    id              BIGSERIAL PRIMARY KEY,
+    organization_id BIGINT       NOT NULL REFERENCES org.organizations (id) ON DELETE CASCADE,
+    kind            VARCHAR(16)  NOT NULL CHECK (kind IN ('head', 'deputy', 'custom')),
+    title           VARCHAR(120) NOT NULL CHECK (LENGTH(TRIM(title)) > 0),
+    user_id         BIGINT,
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trigger_update_positions_updated_at
+    BEFORE UPDATE ON org.positions
+    FOR EACH ROW
+    EXECUTE FUNCTION org.update_updated_at_column();
+
+-- 每个组织至多一个负责人职位
+CREATE UNIQUE INDEX uk_position_head ON org.positions (organization_id) WHERE kind = 'head';
+CREATE INDEX idx_position_org ON org.positions (organization_id);
+CREATE INDEX idx_position_user ON org.positions (user_id) WHERE user_id IS NOT NULL;
+
+COMMENT ON TABLE org.positions IS '组织职位表，记录各组织的负责人、副职等职位及任职用户';
+COMMENT ON COLUMN org.positions.organization_id IS '组织ID';
+COMMENT ON COLUMN org.positions.kind IS '职位类型：head 负责人/deputy 副职/custom 自定义';
+COMMENT ON COLUMN org.positions.title IS '职位名称';
+COMMENT ON COLUMN org.positions.user_id IS '任职用户ID，NULL表示空缺';
+
+COMMIT;
@@ -0,0 +1,37 @@
+-- =========================================================
+-- 010 组织管理授权
+-- 新增 org.admin_grants 表
+-- =========================================================
+BEGIN;
+
+CREATE TABLE org.admin_grants
+(
+    id              BIGSERIAL PRIMARY KEY,
+    user_id         BIGINT      NOT NULL,
+    organization_id BIGINT      NOT NULL REFERENCES org.organizations (id) ON DELETE CASCADE,
+    permissions     TEXT[]      NOT NULL,
+    inherit         BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_admin_grant_permissions CHECK (
+        CARDINALITY(permissions) > 0 AND
+        permissions <@ ARRAY ['create', 'update', 'delete', 'move', 'disable']::TEXT[])
+);
+
+CREATE TRIGGER trigger_update_admin_grants_updated_at
+    BEFORE UPDATE ON org.admin_grants
+    FOR EACH ROW
+    EXECUTE FUNCTION org.update_updated_at_column();
+
+-- 每个用户在每个组织上至多一条授权
+CREATE UNIQUE INDEX uk_admin_grant ON org.admin_grants (user_id, organization_id);
+CREATE INDEX idx_admin_grant_org ON org.admin_grants (organization_id);
+
+COMMENT ON TABLE org.admin_grants IS '组织管理授权表，委派用户管理指定组织（及其下级组织）';
+COMMENT ON COLUMN org.admin_grants.user_id IS '被授权的用户ID，对应访问令牌的 sub';
+COMMENT ON COLUMN org.admin_grants.organization_id IS '授权的组织ID';
+COMMENT ON COLUMN org.admin_grants.permissions IS '授予的权限：create/update/delete/move/disable';
+COMMENT ON COLUMN org.admin_grants.inherit IS '是否同时管理全部下级组织';
+
+COMMIT;
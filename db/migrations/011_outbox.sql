@@ -0,0 +1,35 @@
+-- =========================================================
+-- 011 组织变更事件发件箱
+-- 新增 org.outbox 表
+-- =========================================================
+BEGIN;
+
+CREATE TABLE org.outbox
+(
+    id                 BIGSERIAL PRIMARY KEY,
+    event_type         VARCHAR(16) NOT NULL,
+    organization_id    BIGINT      NOT NULL,
+    previous_parent_id BIGINT,
+    payload            JSONB       NOT NULL,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at       TIMESTAMPTZ,
+    attempts           INTEGER     NOT NULL DEFAULT 0,
+    next_attempt_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error         TEXT
+);
+
+-- 待投递事件按组织保序取出；已投递事件按时间清理
+CREATE INDEX idx_outbox_pending ON org.outbox (organization_id, id) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_published ON org.outbox (published_at) WHERE published_at IS NOT NULL;
+
+COMMENT ON TABLE org.outbox IS '组织变更事件发件箱，与组织变更在同一事务中写入，由服务端投递后标记';
+COMMENT ON COLUMN org.outbox.event_type IS '变更类型：created/updated/moved/disabled/enabled/deleted/restored/reordered/purged';
+COMMENT ON COLUMN org.outbox.organization_id IS '组织ID；不设外键，物理删除后事件仍保留';
+COMMENT ON COLUMN org.outbox.previous_parent_id IS '移动前的父级组织ID，仅 moved 事件，NULL 表示原为根节点';
+COMMENT ON COLUMN org.outbox.payload IS '变更后的组织行快照；purged 事件为删除前的快照';
+COMMENT ON COLUMN org.outbox.published_at IS '投递成功时间，NULL表示待投递';
+COMMENT ON COLUMN org.outbox.attempts IS '失败的投递次数';
+COMMENT ON COLUMN org.outbox.next_attempt_at IS '下次投递时间，失败后按退避推迟';
+COMMENT ON COLUMN org.outbox.last_error IS '最近一次投递失败的原因';
+
+COMMIT;
@@ -0,0 +1,90 @@
+-- =========================================================
+-- 012 Webhook 订阅
+-- 新增 org.webhooks、org.webhook_deliveries、org.webhook_attempts 表
+-- =========================================================
+BEGIN;
+
+CREATE TABLE org.webhooks
+(
+    id                   BIGSERIAL PRIMARY KEY,
+    url                  TEXT         NOT NULL,
+    event_types          TEXT[]       NOT NULL DEFAULT '{}',
+    root_organization_id BIGINT,
+    secret               TEXT         NOT NULL,
+    description          VARCHAR(255) NOT NULL DEFAULT '',
+    created_at           TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trigger_update_webhooks_updated_at
+    BEFORE UPDATE ON org.webhooks
+    FOR EACH ROW
+    EXECUTE FUNCTION org.update_updated_at_column();
+
+CREATE TABLE org.webhook_deliveries
+(
+    id              BIGSERIAL PRIMARY KEY,
+    webhook_id      BIGINT      NOT NULL REFERENCES org.webhooks (id) ON DELETE CASCADE,
+    event_id        BIGINT      NOT NULL,
+    event_type      VARCHAR(16) NOT NULL,
+    payload         TEXT        NOT NULL,
+    status          VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error      TEXT,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    delivered_at    TIMESTAMPTZ,
+
+    CONSTRAINT chk_webhook_delivery_status CHECK (status IN ('pending', 'delivered', 'dead'))
+);
+
+CREATE TRIGGER trigger_update_webhook_deliveries_updated_at
+    BEFORE UPDATE ON org.webhook_deliveries
+    FOR EACH ROW
+    EXECUTE FUNCTION org.update_updated_at_column();
+
+-- 同一事件对每个订阅至多一条投递，发件箱重复发布时不重复投递
+CREATE UNIQUE INDEX uk_webhook_delivery_event ON org.webhook_deliveries (webhook_id, event_id);
+CREATE INDEX idx_webhook_delivery_due ON org.webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+CREATE TABLE org.webhook_attempts
+(
+    id          BIGSERIAL PRIMARY KEY,
+    delivery_id BIGINT      NOT NULL REFERENCES org.webhook_deliveries (id) ON DELETE CASCADE,
+    webhook_id  BIGINT      NOT NULL REFERENCES org.webhooks (id) ON DELETE CASCADE,
+    attempt     INTEGER     NOT NULL,
+    status_code INTEGER     NOT NULL DEFAULT 0,
+    error       TEXT,
+    duration_ms BIGINT      NOT NULL DEFAULT 0,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- 按订阅倒序查询投递记录
+CREATE INDEX idx_webhook_attempt_webhook ON org.webhook_attempts (webhook_id, id);
+CREATE INDEX idx_webhook_attempt_delivery ON org.webhook_attempts (delivery_id);
+
+COMMENT ON TABLE org.webhooks IS 'Webhook 订阅表，组织变更事件按订阅的过滤条件以 HTTP POST 推送';
+COMMENT ON COLUMN org.webhooks.url IS '推送地址';
+COMMENT ON COLUMN org.webhooks.event_types IS '订阅的变更类型，空数组表示全部';
+COMMENT ON COLUMN org.webhooks.root_organization_id IS '仅订阅该组织子树内的变更，NULL表示全部；不设外键，组织物理删除后订阅仍保留';
+COMMENT ON COLUMN org.webhooks.secret IS '请求签名（HMAC-SHA256）使用的密钥';
+COMMENT ON COLUMN org.webhooks.description IS '订阅说明';
+
+COMMENT ON TABLE org.webhook_deliveries IS 'Webhook 投递表，每个事件对每个匹配的订阅一条';
+COMMENT ON COLUMN org.webhook_deliveries.event_id IS '发件箱事件ID（org.outbox.id）';
+COMMENT ON COLUMN org.webhook_deliveries.event_type IS '变更类型';
+COMMENT ON COLUMN org.webhook_deliveries.payload IS '推送的请求体，即 JSON 格式的 OrganizationChanged';
+COMMENT ON COLUMN org.webhook_deliveries.status IS '投递状态：pending 待投递/delivered 已投递/dead 重试耗尽';
+COMMENT ON COLUMN org.webhook_deliveries.attempts IS '已尝试的次数';
+COMMENT ON COLUMN org.webhook_deliveries.next_attempt_at IS '下次投递时间，失败后按退避推迟';
+COMMENT ON COLUMN org.webhook_deliveries.last_error IS '最近一次投递失败的原因';
+COMMENT ON COLUMN org.webhook_deliveries.delivered_at IS '投递成功时间';
+
+COMMENT ON TABLE org.webhook_attempts IS 'Webhook 投递尝试记录';
+COMMENT ON COLUMN org.webhook_attempts.attempt IS '第几次尝试，从 1 开始';
+COMMENT ON COLUMN org.webhook_attempts.status_code IS '响应状态码，0表示未收到响应';
+COMMENT ON COLUMN org.webhook_attempts.error IS '失败原因，NULL表示成功';
+COMMENT ON COLUMN org.webhook_attempts.duration_ms IS '请求耗时（毫秒）';
+
+COMMIT;
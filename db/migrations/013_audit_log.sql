@@ -0,0 +1,96 @@
+-- =========================================================
+-- 013 审计日志
+-- 新增 org.audit_log 表及 org.organizations 上的审计触发器，记录每次组织变更的操作人、接口及变更前后的数据
+-- =========================================================
+BEGIN;
+
+CREATE TABLE org.audit_log
+(
+    id              BIGSERIAL PRIMARY KEY,
+    actor           VARCHAR(128) NOT NULL DEFAULT '',
+    action          VARCHAR(64)  NOT NULL DEFAULT '',
+    organization_id BIGINT       NOT NULL,
+    path_ids        BIGINT[]     NOT NULL DEFAULT '{}',
+    before_data     JSONB,
+    after_data      JSONB,
+    request_id      VARCHAR(128) NOT NULL DEFAULT '',
+    transaction_id  BIGINT       NOT NULL DEFAULT txid_current(),
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+-- 按组织、子树、操作人查询，均按 ID 倒序翻页
+CREATE INDEX idx_audit_log_organization ON org.audit_log (organization_id, id);
+CREATE INDEX idx_audit_log_path_ids ON org.audit_log USING GIN (path_ids);
+CREATE INDEX idx_audit_log_actor ON org.audit_log (actor, id);
+CREATE INDEX idx_audit_log_created_at ON org.audit_log (created_at);
+-- 触发器按事务合并同一组织的多次变更
+CREATE INDEX idx_audit_log_transaction ON org.audit_log (transaction_id, organization_id);
+
+-- 物化路径中的组织 ID，如 /1/5/12/ 为 {1,5,12}
+CREATE OR REPLACE FUNCTION org.audit_path_ids(path TEXT)
+RETURNS BIGINT[] AS $$
+    SELECT COALESCE(string_to_array(trim(BOTH '/' FROM path), '/')::BIGINT[], '{}');
+$$ LANGUAGE sql IMMUTABLE;
+
+-- 记录组织变更：操作人、接口与请求 ID 由服务端在事务开始时通过 set_config 设置，直接修改数据库时为空
+-- 同一事务中对同一组织的多次变更（如创建后写入物化路径）合并为一条，before_data 为事务开始前的状态；
+-- path_ids 包含变更前后两个位置的组织 ID，移出子树的组织在原子树中同样可查
+CREATE OR REPLACE FUNCTION org.audit_organizations()
+RETURNS TRIGGER AS $$
+DECLARE
+    old_row JSONB;
+    new_row JSONB;
+    org_id  BIGINT;
+    ids     BIGINT[];
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        old_row := to_jsonb(OLD);
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        new_row := to_jsonb(NEW);
+    END IF;
+    org_id := (COALESCE(new_row, old_row) ->> 'id')::BIGINT;
+    ids := org.audit_path_ids(old_row ->> 'path') || org.audit_path_ids(new_row ->> 'path');
+
+    UPDATE org.audit_log
+    SET after_data = new_row,
+        path_ids   = ARRAY(SELECT DISTINCT unnest(path_ids || ids))
+    WHERE transaction_id = txid_current()
+      AND organization_id = org_id;
+    IF FOUND THEN
+        RETURN NULL;
+    END IF;
+
+    -- 仅 updated_at 变化的更新不记录
+    IF TG_OP = 'UPDATE' AND old_row - 'updated_at' = new_row - 'updated_at' THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO org.audit_log (actor, action, organization_id, path_ids, before_data, after_data, request_id)
+    VALUES (COALESCE(current_setting('org.audit_actor', true), ''),
+            COALESCE(current_setting('org.audit_action', true), ''),
+            org_id,
+            ARRAY(SELECT DISTINCT unnest(ids)),
+            old_row,
+            new_row,
+            COALESCE(current_setting('org.audit_request_id', true), ''));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_audit_organizations
+    AFTER INSERT OR UPDATE OR DELETE ON org.organizations
+    FOR EACH ROW
+    EXECUTE FUNCTION org.audit_organizations();
+
+COMMENT ON TABLE org.audit_log IS '组织变更审计日志，由触发器写入，同一事务中对同一组织的多次变更合并为一条';
+COMMENT ON COLUMN org.audit_log.actor IS '操作人，取自调用方令牌的 sub 或请求元数据 x-actor；直接修改数据库时为空';
+COMMENT ON COLUMN org.audit_log.action IS '调用的接口，如 UpdateOrganization；直接修改数据库时为空';
+COMMENT ON COLUMN org.audit_log.organization_id IS '变更的组织ID，组织物理删除后保留';
+COMMENT ON COLUMN org.audit_log.path_ids IS '变更前后物化路径中的组织ID，用于按子树查询';
+COMMENT ON COLUMN org.audit_log.before_data IS '变更前的组织数据，创建时为 NULL';
+COMMENT ON COLUMN org.audit_log.after_data IS '变更后的组织数据，物理删除时为 NULL';
+COMMENT ON COLUMN org.audit_log.request_id IS '请求ID，取自请求元数据 x-request-id，缺省为链路追踪ID';
+COMMENT ON COLUMN org.audit_log.transaction_id IS '写入的事务ID，用于合并同一事务中的变更';
+
+COMMIT;
@@ -0,0 +1,57 @@
+-- =========================================================
+-- 014 组织历史版本
+-- 新增 org.organization_history 表及维护触发器，支持按时刻查询组织树
+-- 迁移前的变更没有记录，现有组织以当前状态作为自创建时间起的唯一版本
+-- =========================================================
+BEGIN;
+
+-- 与 org.organizations 的列及顺序一致，触发器按 NEW.* 写入；org.organizations 新增列时需同时在此表末尾的 valid_from 之前新增
+CREATE TABLE org.organization_history
+(
+    LIKE org.organizations,
+    valid_from TIMESTAMPTZ NOT NULL,
+    valid_to   TIMESTAMPTZ,
+
+    PRIMARY KEY (id, valid_from)
+);
+
+-- 按时刻查询各版本，及按父节点、物化路径查询历史子树
+CREATE INDEX idx_org_history_valid ON org.organization_history (valid_from, valid_to);
+CREATE INDEX idx_org_history_parent ON org.organization_history (parent_id);
+CREATE INDEX idx_org_history_path ON org.organization_history (path text_pattern_ops);
+
+-- 维护组织的历史版本：每次变更结束当前版本（valid_to）并写入新版本，物理删除只结束当前版本
+-- 版本以事务开始时间为界，同一事务中的多次变更只保留最终状态；仅 updated_at 变化的更新不产生新版本
+CREATE OR REPLACE FUNCTION org.record_organization_history()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND to_jsonb(OLD) - 'updated_at' = to_jsonb(NEW) - 'updated_at' THEN
+        RETURN NULL;
+    END IF;
+    IF TG_OP <> 'INSERT' THEN
+        DELETE FROM org.organization_history
+        WHERE id = OLD.id AND valid_to IS NULL AND valid_from = NOW();
+        UPDATE org.organization_history SET valid_to = NOW()
+        WHERE id = OLD.id AND valid_to IS NULL;
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        INSERT INTO org.organization_history SELECT NEW.*, NOW(), NULL;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_record_organization_history
+    AFTER INSERT OR UPDATE OR DELETE ON org.organizations
+    FOR EACH ROW
+    EXECUTE FUNCTION org.record_organization_history();
+
+INSERT INTO org.organization_history
+SELECT o.*, o.created_at, NULL
+FROM org.organizations o;
+
+COMMENT ON TABLE org.organization_history IS '组织历史版本，每行为组织在 [valid_from, valid_to) 期间的状态，由触发器维护';
+COMMENT ON COLUMN org.organization_history.valid_from IS '版本生效时间，即产生该版本的事务开始时间';
+COMMENT ON COLUMN org.organization_history.valid_to IS '版本失效时间，NULL表示当前版本；组织物理删除后不再有当前版本';
+
+COMMIT;
@@ -0,0 +1,16 @@
+-- =========================================================
+-- 015 发件箱死信
+-- org.outbox 新增 dead_at 列：投递失败次数达到上限的事件转为死信，不再阻塞同一组织的后续事件及清理
+-- =========================================================
+BEGIN;
+
+ALTER TABLE org.outbox ADD COLUMN dead_at TIMESTAMPTZ;
+
+DROP INDEX org.idx_outbox_pending;
+CREATE INDEX idx_outbox_pending ON org.outbox (organization_id, id) WHERE published_at IS NULL AND dead_at IS NULL;
+CREATE INDEX idx_outbox_dead ON org.outbox (dead_at) WHERE dead_at IS NOT NULL;
+
+COMMENT ON COLUMN org.outbox.next_attempt_at IS '下次投递时间，失败后按退避推迟，领取后推迟一个租期';
+COMMENT ON COLUMN org.outbox.dead_at IS '投递失败次数达到上限、转为死信的时间，NULL表示未转为死信；死信不再投递，也不再阻塞同一组织的后续事件';
+
+COMMIT;
@@ -0,0 +1,50 @@
+-- =========================================================
+-- 016 组织历史版本的时间边界
+-- org.organization_history 新增 transaction_id 列及 valid_to > valid_from 约束，触发器改为按列名写入：
+-- 事务开始早于当前版本的生效时间（并发事务先提交）时，以当前版本的生效时间之后作为边界，避免产生 valid_to < valid_from 的版本
+-- 已产生的此类版本在任何时刻都不会被查询到，直接删除；其相邻版本可能在并发的时间段内重叠，无法还原
+-- =========================================================
+BEGIN;
+
+DELETE FROM org.organization_history WHERE valid_to <= valid_from;
+
+ALTER TABLE org.organization_history
+    ADD COLUMN transaction_id BIGINT NOT NULL DEFAULT txid_current(),
+    ADD CONSTRAINT chk_org_history_valid CHECK (valid_to IS NULL OR valid_to > valid_from);
+
+-- 维护组织的历史版本：每次变更结束当前版本（valid_to）并写入新版本，物理删除只结束当前版本
+-- 版本以事务开始时间为界，同一事务中的多次变更只保留最终状态；仅 updated_at 变化的更新不产生新版本
+-- 事务开始早于当前版本的生效时间（并发事务先提交）时，以当前版本的生效时间之后作为边界，保证各版本首尾相接、不重叠
+CREATE OR REPLACE FUNCTION org.record_organization_history()
+RETURNS TRIGGER AS $$
+DECLARE
+    stamp TIMESTAMPTZ;
+BEGIN
+    IF TG_OP = 'UPDATE' AND to_jsonb(OLD) - 'updated_at' = to_jsonb(NEW) - 'updated_at' THEN
+        RETURN NULL;
+    END IF;
+    IF TG_OP <> 'INSERT' THEN
+        -- 同一事务中先前写入的版本由本次变更取代，沿用其生效时间
+        DELETE FROM org.organization_history
+        WHERE id = OLD.id AND valid_to IS NULL AND transaction_id = txid_current()
+        RETURNING valid_from INTO stamp;
+        IF stamp IS NULL THEN
+            UPDATE org.organization_history SET valid_to = GREATEST(NOW(), valid_from + INTERVAL '1 microsecond')
+            WHERE id = OLD.id AND valid_to IS NULL
+            RETURNING valid_to INTO stamp;
+        END IF;
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        INSERT INTO org.organization_history (id, parent_id, name, created_at, updated_at, disabled_at, deleted_at, name_key,
+                                              path, org_type, code, attributes, sort_order, valid_from, valid_to)
+        VALUES (NEW.id, NEW.parent_id, NEW.name, NEW.created_at, NEW.updated_at, NEW.disabled_at, NEW.deleted_at, NEW.name_key,
+                NEW.path, NEW.org_type, NEW.code, NEW.attributes, NEW.sort_order, COALESCE(stamp, NOW()), NULL);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMENT ON COLUMN org.organization_history.valid_from IS '版本生效时间，即产生该版本的事务开始时间，不早于上一版本的生效时间';
+COMMENT ON COLUMN org.organization_history.transaction_id IS '写入该版本的事务ID，同一事务中的多次变更合并为一个版本';
+
+COMMIT;
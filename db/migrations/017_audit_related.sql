@@ -0,0 +1,139 @@
+-- =========================================================
+-- 017 审计组织下属数据
+-- org.audit_log 新增 entity、entity_id 列，成员、职位、管理授权与 Webhook 订阅的变更同样写入审计日志
+-- =========================================================
+BEGIN;
+
+ALTER TABLE org.audit_log
+    ADD COLUMN entity    VARCHAR(32) NOT NULL DEFAULT 'organization',
+    ADD COLUMN entity_id BIGINT;
+UPDATE org.audit_log SET entity_id = organization_id;
+ALTER TABLE org.audit_log ALTER COLUMN entity_id SET NOT NULL;
+
+DROP INDEX org.idx_audit_log_transaction;
+CREATE INDEX idx_audit_log_transaction ON org.audit_log (transaction_id, entity, entity_id);
+
+-- 记录组织变更：操作人、接口与请求 ID 由服务端在事务开始时通过 set_config 设置，直接修改数据库时为空
+-- 同一事务中对同一组织的多次变更（如创建后写入物化路径）合并为一条，before_data 为事务开始前的状态；
+-- path_ids 包含变更前后两个位置的组织 ID，移出子树的组织在原子树中同样可查
+CREATE OR REPLACE FUNCTION org.audit_organizations()
+RETURNS TRIGGER AS $$
+DECLARE
+    old_row JSONB;
+    new_row JSONB;
+    org_id  BIGINT;
+    ids     BIGINT[];
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        old_row := to_jsonb(OLD);
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        new_row := to_jsonb(NEW);
+    END IF;
+    org_id := (COALESCE(new_row, old_row) ->> 'id')::BIGINT;
+    ids := org.audit_path_ids(old_row ->> 'path') || org.audit_path_ids(new_row ->> 'path');
+
+    UPDATE org.audit_log
+    SET after_data = new_row,
+        path_ids   = ARRAY(SELECT DISTINCT unnest(path_ids || ids))
+    WHERE transaction_id = txid_current()
+      AND entity = 'organization'
+      AND entity_id = org_id;
+    IF FOUND THEN
+        RETURN NULL;
+    END IF;
+
+    -- 仅 updated_at 变化的更新不记录
+    IF TG_OP = 'UPDATE' AND old_row - 'updated_at' = new_row - 'updated_at' THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO org.audit_log (entity, entity_id, actor, action, organization_id, path_ids, before_data, after_data, request_id)
+    VALUES ('organization',
+            org_id,
+            COALESCE(current_setting('org.audit_actor', true), ''),
+            COALESCE(current_setting('org.audit_action', true), ''),
+            org_id,
+            ARRAY(SELECT DISTINCT unnest(ids)),
+            old_row,
+            new_row,
+            COALESCE(current_setting('org.audit_request_id', true), ''));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- 记录组织下属数据的变更，TG_ARGV[0] 为记录类型，TG_ARGV[1] 为所属组织的列名：
+-- organization_id 为所属组织（不限子树的 Webhook 订阅为 0），path_ids 为所属组织当前物化路径中的组织 ID；
+-- 同一事务中对同一记录的多次变更合并为一条；Webhook 订阅的签名密钥不记录
+CREATE OR REPLACE FUNCTION org.audit_related()
+RETURNS TRIGGER AS $$
+DECLARE
+    old_row JSONB;
+    new_row JSONB;
+    row_id  BIGINT;
+    org_id  BIGINT;
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        old_row := to_jsonb(OLD) - 'secret';
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        new_row := to_jsonb(NEW) - 'secret';
+    END IF;
+    row_id := (COALESCE(new_row, old_row) ->> 'id')::BIGINT;
+    org_id := COALESCE((COALESCE(new_row, old_row) ->> TG_ARGV[1])::BIGINT, 0);
+
+    UPDATE org.audit_log
+    SET after_data = new_row
+    WHERE transaction_id = txid_current()
+      AND entity = TG_ARGV[0]
+      AND entity_id = row_id;
+    IF FOUND THEN
+        RETURN NULL;
+    END IF;
+
+    IF TG_OP = 'UPDATE' AND old_row - 'updated_at' = new_row - 'updated_at' THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO org.audit_log (entity, entity_id, actor, action, organization_id, path_ids, before_data, after_data, request_id)
+    VALUES (TG_ARGV[0],
+            row_id,
+            COALESCE(current_setting('org.audit_actor', true), ''),
+            COALESCE(current_setting('org.audit_action', true), ''),
+            org_id,
+            COALESCE((SELECT org.audit_path_ids(o.path) FROM org.organizations o WHERE o.id = org_id), '{}'),
+            old_row,
+            new_row,
+            COALESCE(current_setting('org.audit_request_id', true), ''));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_audit_memberships
+    AFTER INSERT OR UPDATE OR DELETE ON org.memberships
+    FOR EACH ROW
+    EXECUTE FUNCTION org.audit_related('membership', 'organization_id');
+
+CREATE TRIGGER trigger_audit_positions
+    AFTER INSERT OR UPDATE OR DELETE ON org.positions
+    FOR EACH ROW
+    EXECUTE FUNCTION org.audit_related('position', 'organization_id');
+
+CREATE TRIGGER trigger_audit_admin_grants
+    AFTER INSERT OR UPDATE OR DELETE ON org.admin_grants
+    FOR EACH ROW
+    EXECUTE FUNCTION org.audit_related('admin_grant', 'organization_id');
+
+CREATE TRIGGER trigger_audit_webhooks
+    AFTER INSERT OR UPDATE OR DELETE ON org.webhooks
+    FOR EACH ROW
+    EXECUTE FUNCTION org.audit_related('webhook', 'root_organization_id');
+
+COMMENT ON TABLE org.audit_log IS '审计日志，记录组织及其成员、职位、管理授权、Webhook 订阅的变更，由触发器写入，同一事务中对同一记录的多次变更合并为一条';
+COMMENT ON COLUMN org.audit_log.organization_id IS '变更的组织ID或变更记录所属的组织ID（不限子树的 Webhook 订阅为0），组织物理删除后保留';
+COMMENT ON COLUMN org.audit_log.before_data IS '变更前的记录数据，创建时为 NULL';
+COMMENT ON COLUMN org.audit_log.after_data IS '变更后的记录数据，删除时为 NULL';
+COMMENT ON COLUMN org.audit_log.entity IS '变更的记录类型：organization/membership/position/admin_grant/webhook';
+COMMENT ON COLUMN org.audit_log.entity_id IS '变更的记录ID，组织变更时与 organization_id 相同';
+
+COMMIT;
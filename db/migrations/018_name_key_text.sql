@@ -0,0 +1,13 @@
+-- =========================================================
+-- 018 name_key 不限制长度
+-- 名称规范化后可能长于名称本身（如大小写折叠将 ß 展开为 ss），VARCHAR(120) 会溢出
+-- 迁移后执行 organization -rebuild-name-keys，以服务端的规范化规则替换 001 中按 SQL 近似回填的结果
+-- =========================================================
+BEGIN;
+
+ALTER TABLE org.organizations ALTER COLUMN name_key TYPE TEXT;
+ALTER TABLE org.organization_history ALTER COLUMN name_key TYPE TEXT;
+
+COMMENT ON COLUMN org.organizations.name_key IS '规范化名称，用于同级重名校验，由服务端按配置生成；修改规范化配置后需执行 organization -rebuild-name-keys';
+
+COMMIT;
@@ -0,0 +1,11 @@
+-- =========================================================
+-- 019 组织管理授权的过期时间
+-- org.admin_grants 新增 expires_at 列，已有授权长期有效
+-- =========================================================
+BEGIN;
+
+ALTER TABLE org.admin_grants ADD COLUMN expires_at TIMESTAMPTZ;
+
+COMMENT ON COLUMN org.admin_grants.expires_at IS '过期时间，过期后授权不再生效；NULL 表示长期有效';
+
+COMMIT;
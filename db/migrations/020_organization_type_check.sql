@@ -0,0 +1,14 @@
+-- =========================================================
+-- 020 组织类型取值约束
+-- 已执行 004 的数据库补充 chk_org_type；此前写入的未定义类型（读取时为 ORG_TYPE_UNSPECIFIED）改为 department
+-- =========================================================
+BEGIN;
+
+UPDATE org.organizations SET org_type = 'department'
+WHERE org_type NOT IN ('group', 'company', 'division', 'department', 'team');
+
+ALTER TABLE org.organizations DROP CONSTRAINT IF EXISTS chk_org_type;
+ALTER TABLE org.organizations
+    ADD CONSTRAINT chk_org_type CHECK (org_type IN ('group', 'company', 'division', 'department', 'team'));
+
+COMMIT;
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ AdminGrantsModel = (*customAdminGrantsModel)(nil)
+
+type (
+	// AdminGrantsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customAdminGrantsModel.
+	AdminGrantsModel interface {
+		adminGrantsModel
+		Upsert(ctx context.Context, data *AdminGrants) error                                                            // 新增授权，用户在该组织上已有授权时整体替换
+		FindByFilter(ctx context.Context, userId, organizationId int64) ([]*AdminGrants, error)                         // 按用户和（或）组织查询授权
+		FindScopes(ctx context.Context, session sqlx.Session, userId, organizationId int64) ([]*AdminGrantScope, error) // 查询用户在组织自身及其祖先上的授权
+	}
+
+	customAdminGrantsModel struct {
+		*defaultAdminGrantsModel
+	}
+
+	// AdminGrantScope 用户在目标组织自身或其祖先上的授权，Depth 为授权组织到目标组织的层级距离，0 表示目标组织自身
+	AdminGrantScope struct {
+		Id          int64          `db:"id"`
+		Permissions pq.StringArray `db:"permissions"`
+		Inherit     bool           `db:"inherit"`
+		ExpiresAt   sql.NullTime   `db:"expires_at"`
+		Depth       int64          `db:"depth"`
+	}
+)
+
+// NewAdminGrantsModel returns a model for the database table.
+func NewAdminGrantsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) AdminGrantsModel {
+	return &customAdminGrantsModel{
+		defaultAdminGrantsModel: newAdminGrantsModel(conn, c, opts...),
+	}
+}
+
+// Upsert 新增授权，用户在该组织上已有授权时替换其权限与继承标志，写回 ID
+func (m *customAdminGrantsModel) Upsert(ctx context.Context, data *AdminGrants) error {
+	query := fmt.Sprintf(`insert into %s (%s) values ($1, $2, $3, $4, $5)
+on conflict (user_id, organization_id) do update set permissions = excluded.permissions, inherit = excluded.inherit, expires_at = excluded.expires_at
+returning id`, m.table, adminGrantsRowsExpectAutoSet)
+	err := auditTransact(ctx, m, func(ctx context.Context, session sqlx.Session) error {
+		return session.QueryRowCtx(ctx, &data.Id, query, data.UserId, data.OrganizationId, data.Permissions, data.Inherit, data.ExpiresAt)
+	})
+	if err != nil {
+		return err
+	}
+	return m.DelCacheCtx(ctx, fmt.Sprintf("%s%v", cacheOrgAdminGrantsIdPrefix, data.Id))
+}
+
+// Delete 重写Delete方法，在审计事务中删除授权
+func (m *customAdminGrantsModel) Delete(ctx context.Context, id int64) error {
+	err := auditTransact(ctx, m, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("delete from %s where id = $1", m.table)
+		_, err := session.ExecCtx(ctx, query, id)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return m.DelCacheCtx(ctx, fmt.Sprintf("%s%v", cacheOrgAdminGrantsIdPrefix, id))
+}
+
+// FindByFilter 按用户和（或）组织查询授权，参数为 0 表示不按该条件过滤，按 ID 排序
+func (m *customAdminGrantsModel) FindByFilter(ctx context.Context, userId, organizationId int64) ([]*AdminGrants, error) {
+	conds := []string{"true"}
+	var args []any
+	if userId != 0 {
+		args = append(args, userId)
+		conds = append(conds, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	if organizationId != 0 {
+		args = append(args, organizationId)
+		conds = append(conds, fmt.Sprintf("organization_id = $%d", len(args)))
+	}
+	query := fmt.Sprintf("select %s from %s where %s order by id", adminGrantsRows, m.table, strings.Join(conds, " and "))
+	var resp []*AdminGrants
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+// FindScopes 沿闭包表查询用户在组织自身及其祖先上的授权（含已过期的授权），是否覆盖目标组织由调用方判断；
+// session 不为 nil 时在该事务中查询并以共享锁锁定查到的授权，事务提交前撤销或修改这些授权需等待
+func (m *customAdminGrantsModel) FindScopes(ctx context.Context, session sqlx.Session, userId, organizationId int64) ([]*AdminGrantScope, error) {
+	query := fmt.Sprintf(`select g.id, g.permissions, g.inherit, g.expires_at, c.depth from %s g join %s c on c.ancestor_id = g.organization_id
+where g.user_id = $1 and c.descendant_id = $2 order by c.depth`, m.table, organizationClosureTable)
+	var resp []*AdminGrantScope
+	if session == nil {
+		err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, organizationId)
+		return resp, err
+	}
+	err := session.QueryRowsCtx(ctx, &resp, query+" for share of g", userId, organizationId)
+	return resp, err
+}
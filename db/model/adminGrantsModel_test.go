@@ -0,0 +1,66 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// TestAdminGrantScopes 在组织及其祖先上授权，检查沿闭包表查到的授权及其层级距离，撤销后不再查到
+func TestAdminGrantScopes(t *testing.T) {
+	conn := newTestConn(t)
+	m := NewOrganizationsModel(conn, testCacheConf)
+	grants := NewAdminGrantsModel(conn, testCacheConf)
+	ctx := context.Background()
+
+	prefix := fmt.Sprintf("grant-%d", time.Now().UnixNano())
+	rootId := insertTestOrg(t, ctx, m, prefix, prefix, 0)
+	aId := insertTestOrg(t, ctx, m, prefix, "a", rootId)
+	bId := insertTestOrg(t, ctx, m, prefix, "b", aId)
+	cleanupTestOrgs(t, conn, rootId, aId, bId)
+
+	const userId = 1 << 40
+	expires := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+	rootGrant := &AdminGrants{UserId: userId, OrganizationId: rootId, Permissions: []string{"update"}, Inherit: true, ExpiresAt: sql.NullTime{Valid: true, Time: expires}}
+	bGrant := &AdminGrants{UserId: userId, OrganizationId: bId, Permissions: []string{"delete"}}
+	for _, g := range []*AdminGrants{rootGrant, bGrant} {
+		if err := grants.Upsert(ctx, g); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	scopes, err := grants.FindScopes(ctx, nil, userId, bId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scopes) != 2 || scopes[0].Id != bGrant.Id || scopes[0].Depth != 0 || scopes[1].Id != rootGrant.Id || scopes[1].Depth != 2 {
+		t.Fatalf("scopes = %+v, want grant %d at depth 0 and %d at depth 2", scopes, bGrant.Id, rootGrant.Id)
+	}
+	if !scopes[1].Inherit || !scopes[1].ExpiresAt.Valid || !scopes[1].ExpiresAt.Time.Equal(expires) {
+		t.Errorf("root scope = %+v, want inherit and expires at %v", scopes[1], expires)
+	}
+
+	// 事务中查询并锁定授权
+	err = conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		scopes, err := grants.FindScopes(ctx, session, userId, aId)
+		if err == nil && (len(scopes) != 1 || scopes[0].Id != rootGrant.Id || scopes[0].Depth != 1) {
+			err = fmt.Errorf("scopes in transaction = %+v, want grant %d at depth 1", scopes, rootGrant.Id)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := grants.Delete(ctx, rootGrant.Id); err != nil {
+		t.Fatal(err)
+	}
+	scopes, err = grants.FindScopes(ctx, nil, userId, aId)
+	if err != nil || len(scopes) != 0 {
+		t.Fatalf("scopes after revoke = %+v, %v; want none", scopes, err)
+	}
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ AuditLogModel = (*customAuditLogModel)(nil)
+
+type (
+	// AuditLogModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customAuditLogModel.
+	AuditLogModel interface {
+		auditLogModel
+		withSession(session sqlx.Session) AuditLogModel
+		FindPage(ctx context.Context, filter *AuditLogFilter, limit int64) ([]*AuditLog, error) // 按 ID 倒序分页查询审计日志
+	}
+
+	customAuditLogModel struct {
+		*defaultAuditLogModel
+	}
+
+	// AuditLogFilter 审计日志查询条件，零值字段不参与过滤
+	AuditLogFilter struct {
+		OrganizationId     int64     // 组织 ID
+		IncludeDescendants bool      // 同时查询 OrganizationId 子树内（含变更时位于子树内）的组织
+		Actor              string    // 操作人
+		Action             string    // 接口名
+		StartTime          time.Time // 起始时间（含）
+		EndTime            time.Time // 截止时间（不含）
+		BeforeId           int64     // 仅查询 ID 小于该值的记录；0 表示从最新开始
+	}
+)
+
+// NewAuditLogModel returns a model for the database table.
+func NewAuditLogModel(conn sqlx.SqlConn) AuditLogModel {
+	return &customAuditLogModel{
+		defaultAuditLogModel: newAuditLogModel(conn),
+	}
+}
+
+func (m *customAuditLogModel) withSession(session sqlx.Session) AuditLogModel {
+	return NewAuditLogModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// FindPage 按 ID 倒序查询审计日志，以上一页最后一条的 ID 作为 BeforeId 翻页
+func (m *customAuditLogModel) FindPage(ctx context.Context, filter *AuditLogFilter, limit int64) ([]*AuditLog, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	if filter.OrganizationId > 0 {
+		args = append(args, filter.OrganizationId)
+		if filter.IncludeDescendants {
+			conds = append(conds, fmt.Sprintf("path_ids @> array[$%d::bigint]", len(args)))
+		} else {
+			conds = append(conds, fmt.Sprintf("organization_id = $%d", len(args)))
+		}
+	}
+	if filter.Actor != "" {
+		args = append(args, filter.Actor)
+		conds = append(conds, fmt.Sprintf("actor = $%d", len(args)))
+	}
+	if filter.Action != "" {
+		args = append(args, filter.Action)
+		conds = append(conds, fmt.Sprintf("action = $%d", len(args)))
+	}
+	if !filter.StartTime.IsZero() {
+		args = append(args, filter.StartTime)
+		conds = append(conds, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if !filter.EndTime.IsZero() {
+		args = append(args, filter.EndTime)
+		conds = append(conds, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	if filter.BeforeId > 0 {
+		args = append(args, filter.BeforeId)
+		conds = append(conds, fmt.Sprintf("id < $%d", len(args)))
+	}
+	where := "true"
+	if len(conds) > 0 {
+		where = strings.Join(conds, " and ")
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf("select %s from %s where %s order by id desc limit $%d", auditLogRows, m.table, where, len(args))
+	var resp []*AuditLog
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, args...)
+	return resp, err
+}
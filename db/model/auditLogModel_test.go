@@ -0,0 +1,108 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestAuditLog 创建、移动组织并添加成员，检查触发器写入的审计日志及按子树、按 before_id 的查询
+func TestAuditLog(t *testing.T) {
+	conn := newTestConn(t)
+	m := NewOrganizationsModel(conn, testCacheConf)
+	memberships := NewMembershipsModel(conn, testCacheConf)
+	auditLog := NewAuditLogModel(conn)
+
+	prefix := fmt.Sprintf("audit-%d", time.Now().UnixNano())
+	ctx := WithAuditInfo(context.Background(), &AuditInfo{Actor: prefix, Action: "TestAuditLog", RequestId: prefix})
+	rootId := insertTestOrg(t, ctx, m, prefix, prefix, 0)
+	aId := insertTestOrg(t, ctx, m, prefix, "a", rootId)
+	bId := insertTestOrg(t, ctx, m, prefix, "b", rootId)
+	cId := insertTestOrg(t, ctx, m, prefix, "c", aId)
+	cleanupTestOrgs(t, conn, rootId, aId, bId, cId)
+	if err := m.Move(ctx, cId, bId, Placement{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := memberships.AddMember(ctx, &Memberships{OrganizationId: cId, UserId: 1, JoinedAt: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+
+	find := func(filter AuditLogFilter, limit int64) []*AuditLog {
+		t.Helper()
+		filter.Actor = prefix
+		entries, err := auditLog.FindPage(ctx, &filter, limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return entries
+	}
+
+	t.Run("merge in transaction", func(t *testing.T) {
+		// 创建时插入后再写入物化路径，同一事务中的两次变更合并为一条
+		entries := find(AuditLogFilter{OrganizationId: aId}, 10)
+		if len(entries) != 1 {
+			t.Fatalf("entries for a = %d, want 1", len(entries))
+		}
+		entry := entries[0]
+		if entry.BeforeData.Valid || entry.Entity != "organization" || entry.Action != "TestAuditLog" || entry.RequestId != prefix {
+			t.Errorf("entry = %+v; want a creation by TestAuditLog", entry)
+		}
+		var after struct {
+			Path string `json:"path"`
+		}
+		if err := json.Unmarshal([]byte(entry.AfterData.String), &after); err != nil {
+			t.Fatal(err)
+		}
+		if want := fmt.Sprintf("/%d/%d/", rootId, aId); after.Path != want {
+			t.Errorf("after path = %q, want %q", after.Path, want)
+		}
+	})
+
+	t.Run("subtree", func(t *testing.T) {
+		// c 移出 a 的子树后，移动记录在 a 与 b 的子树中都可查；移动之后的成员变更只计入 c 当前所在的子树
+		for _, tt := range []struct {
+			rootId int64
+			want   []string
+		}{
+			{aId, []string{"organization:moved", "organization", "organization"}},
+			{bId, []string{"membership", "organization:moved", "organization"}},
+		} {
+			var got []string
+			for _, entry := range find(AuditLogFilter{OrganizationId: tt.rootId, IncludeDescendants: true}, 10) {
+				kind := entry.Entity
+				if entry.Entity == "organization" && entry.BeforeData.Valid {
+					kind += ":moved"
+				}
+				got = append(got, kind)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("subtree of %d = %v, want %v", tt.rootId, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("before id paging", func(t *testing.T) {
+		all := find(AuditLogFilter{}, 100)
+		var paged []*AuditLog
+		filter := AuditLogFilter{}
+		for {
+			page := find(filter, 2)
+			paged = append(paged, page...)
+			if len(page) < 2 {
+				break
+			}
+			filter.BeforeId = page[len(page)-1].Id
+		}
+		if len(all) == 0 || len(paged) != len(all) {
+			t.Fatalf("paged %d entries, want %d", len(paged), len(all))
+		}
+		for i := range all {
+			if paged[i].Id != all[i].Id {
+				t.Fatalf("entry %d: paged id %d, want %d", i, paged[i].Id, all[i].Id)
+			}
+		}
+	})
+}
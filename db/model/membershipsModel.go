@@ -0,0 +1,166 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ MembershipsModel = (*customMembershipsModel)(nil)
+
+type (
+	// MembershipsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customMembershipsModel.
+	MembershipsModel interface {
+		membershipsModel
+		AddMember(ctx context.Context, data *Memberships) error                                   // 添加成员，设为主组织时取消用户原主组织
+		RemoveMember(ctx context.Context, organizationId, userId int64) error                     // 移除成员（记录离开时间）
+		FindByUserId(ctx context.Context, userId int64, includeLeft bool) ([]*Memberships, error) // 查询用户的组织归属
+		FindPrimary(ctx context.Context, userId int64) (*Memberships, error)                      // 查询用户当前的主组织归属
+
+		CountMembers(ctx context.Context, organizationId int64, includeSubtree bool) (int64, error)                              // 统计组织当前成员数量
+		FindMembers(ctx context.Context, organizationId int64, includeSubtree bool, limit, offset int64) ([]*Memberships, error) // 分页查询组织当前成员
+	}
+
+	customMembershipsModel struct {
+		*defaultMembershipsModel
+	}
+)
+
+// membershipsTable 成员关系表，组织软删除时在同一事务中结束其成员关系
+const membershipsTable = `"org"."memberships"`
+
+// organizationsTable 组织表，添加成员时锁定所属组织
+const organizationsTable = `"org"."organizations"`
+
+// activeMembershipIndex 同一用户在同一组织只能有一条未离开的成员关系
+const activeMembershipIndex = "uk_membership_active"
+
+// membershipsRowsWithAlias 带 m. 表别名的查询列
+var membershipsRowsWithAlias = "m." + strings.Join(membershipsFieldNames, ",m.")
+
+// NewMembershipsModel returns a model for the database table.
+func NewMembershipsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) MembershipsModel {
+	return &customMembershipsModel{
+		defaultMembershipsModel: newMembershipsModel(conn, c, opts...),
+	}
+}
+
+// AddMember 在一个事务中添加成员：锁定组织行防止并发软删除，设为主组织时先取消用户原主组织
+// 组织不存在或已删除返回 ErrNotFound，已是成员返回 ErrAlreadyMember
+func (m *customMembershipsModel) AddMember(ctx context.Context, data *Memberships) error {
+	var demoted []int64
+	err := auditTransact(ctx, m, func(ctx context.Context, session sqlx.Session) error {
+		var organizationId int64
+		query := fmt.Sprintf("select id from %s where id = $1 and deleted_at IS NULL limit 1 for share", organizationsTable)
+		if err := session.QueryRowCtx(ctx, &organizationId, query, data.OrganizationId); err != nil {
+			if errors.Is(err, sqlx.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		if data.IsPrimary {
+			query = fmt.Sprintf("update %s set is_primary = false where user_id = $1 and is_primary and left_at IS NULL returning id", m.table)
+			if err := session.QueryRowsCtx(ctx, &demoted, query, data.UserId); err != nil {
+				return err
+			}
+		}
+
+		query = fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5) RETURNING id, created_at, updated_at", m.table, membershipsRowsExpectAutoSet)
+		return session.QueryRowPartialCtx(ctx, data, query, data.OrganizationId, data.UserId, data.IsPrimary, data.JoinedAt, data.LeftAt)
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == activeMembershipIndex {
+			return ErrAlreadyMember
+		}
+		return err
+	}
+
+	keys := make([]string, 0, len(demoted)+1)
+	keys = append(keys, fmt.Sprintf("%s%v", cacheOrgMembershipsIdPrefix, data.Id))
+	for _, id := range demoted {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheOrgMembershipsIdPrefix, id))
+	}
+	return m.DelCacheCtx(ctx, keys...)
+}
+
+// RemoveMember 结束用户在组织中的成员关系，不存在未离开的成员关系时返回 ErrNotFound
+func (m *customMembershipsModel) RemoveMember(ctx context.Context, organizationId, userId int64) error {
+	var ids []int64
+	err := auditTransact(ctx, m, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("update %s set left_at = NOW() where organization_id = $1 and user_id = $2 and left_at IS NULL returning id", m.table)
+		return session.QueryRowsCtx(ctx, &ids, query, organizationId, userId)
+	})
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return ErrNotFound
+	}
+
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheOrgMembershipsIdPrefix, id))
+	}
+	return m.DelCacheCtx(ctx, keys...)
+}
+
+// FindByUserId 查询用户的组织归属，主组织在前，其余按加入时间排序；includeLeft 为 true 时包含已离开的记录
+func (m *customMembershipsModel) FindByUserId(ctx context.Context, userId int64, includeLeft bool) ([]*Memberships, error) {
+	cond := " and left_at IS NULL"
+	if includeLeft {
+		cond = ""
+	}
+	query := fmt.Sprintf("select %s from %s where user_id = $1%s order by left_at IS NOT NULL, is_primary desc, joined_at, id", membershipsRows, m.table, cond)
+	var resp []*Memberships
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId)
+	return resp, err
+}
+
+// FindPrimary 查询用户当前的主组织归属，用户没有主组织时返回 ErrNotFound
+func (m *customMembershipsModel) FindPrimary(ctx context.Context, userId int64) (*Memberships, error) {
+	query := fmt.Sprintf("select %s from %s where user_id = $1 and is_primary and left_at IS NULL limit 1", membershipsRows, m.table)
+	var resp Memberships
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, userId)
+	switch {
+	case err == nil:
+		return &resp, nil
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// CountMembers 统计组织当前成员数量，includeSubtree 为 true 时包含所有后代组织（同一用户在多个组织中按多条计）
+func (m *customMembershipsModel) CountMembers(ctx context.Context, organizationId int64, includeSubtree bool) (int64, error) {
+	query := fmt.Sprintf("select count(*) from %s m where %s and m.left_at IS NULL", m.table, membersScope(includeSubtree))
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, organizationId)
+	return count, err
+}
+
+// FindMembers 分页查询组织当前成员，按加入时间排序
+func (m *customMembershipsModel) FindMembers(ctx context.Context, organizationId int64, includeSubtree bool, limit, offset int64) ([]*Memberships, error) {
+	query := fmt.Sprintf("select %s from %s m where %s and m.left_at IS NULL order by m.joined_at, m.id limit $2 offset $3",
+		membershipsRowsWithAlias, m.table, membersScope(includeSubtree))
+	var resp []*Memberships
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, organizationId, limit, offset)
+	return resp, err
+}
+
+// membersScope 成员所属组织的查询条件，子树范围通过闭包表展开
+func membersScope(includeSubtree bool) string {
+	if includeSubtree {
+		return fmt.Sprintf("m.organization_id in (select descendant_id from %s where ancestor_id = $1)", organizationClosureTable)
+	}
+	return "m.organization_id = $1"
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// TestAddMemberPrimary 依次添加成员，检查设为主组织时取消原主组织，以及重复添加返回 ErrAlreadyMember
+func TestAddMemberPrimary(t *testing.T) {
+	conn := newTestConn(t)
+	m := NewOrganizationsModel(conn, testCacheConf)
+	memberships := NewMembershipsModel(conn, testCacheConf)
+	ctx := context.Background()
+
+	prefix := fmt.Sprintf("member-%d", time.Now().UnixNano())
+	rootId := insertTestOrg(t, ctx, m, prefix, prefix, 0)
+	aId := insertTestOrg(t, ctx, m, prefix, "a", rootId)
+	bId := insertTestOrg(t, ctx, m, prefix, "b", rootId)
+	cleanupTestOrgs(t, conn, rootId, aId, bId)
+
+	userId := time.Now().UnixNano()
+	steps := []struct {
+		name           string
+		organizationId int64
+		primary        bool
+		err            error
+		wantPrimary    int64 // 之后用户的主组织
+	}{
+		{name: "first primary", organizationId: rootId, primary: true, wantPrimary: rootId},
+		{name: "secondary keeps primary", organizationId: aId, wantPrimary: rootId},
+		{name: "new primary demotes", organizationId: bId, primary: true, wantPrimary: bId},
+		{name: "already member", organizationId: aId, primary: true, err: ErrAlreadyMember, wantPrimary: bId},
+	}
+	for _, step := range steps {
+		err := memberships.AddMember(ctx, &Memberships{OrganizationId: step.organizationId, UserId: userId, IsPrimary: step.primary, JoinedAt: time.Now()})
+		if !errors.Is(err, step.err) {
+			t.Fatalf("%s: err = %v, want %v", step.name, err, step.err)
+		}
+
+		primary, err := memberships.FindPrimary(ctx, userId)
+		if err != nil || primary.OrganizationId != step.wantPrimary {
+			t.Fatalf("%s: primary = %+v, %v; want organization %d", step.name, primary, err, step.wantPrimary)
+		}
+		// 至多一个主组织，且已取消的主组织在缓存中同样已更新
+		all, err := memberships.FindByUserId(ctx, userId, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, membership := range all {
+			cached, err := memberships.FindOne(ctx, membership.Id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			isPrimary := membership.OrganizationId == step.wantPrimary
+			if membership.IsPrimary != isPrimary || cached.IsPrimary != isPrimary {
+				t.Errorf("%s: membership in %d primary = %v (cached %v), want %v", step.name, membership.OrganizationId, membership.IsPrimary, cached.IsPrimary, isPrimary)
+			}
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// emptyAttributes 未设置扩展属性时写入的空对象
+const emptyAttributes = "{}"
+
+// FindByAttributes 查询扩展属性包含 attrs 中全部键值的未删除组织，按 ID 排序分页
+// 使用 jsonb 包含运算符 @>，可命中 GIN 索引 idx_org_attributes
+func (m *customOrganizationsModel) FindByAttributes(ctx context.Context, attrs map[string]any, limit, offset int64) ([]*Organizations, error) {
+	contained := []byte(emptyAttributes)
+	if len(attrs) > 0 {
+		var err error
+		if contained, err = json.Marshal(attrs); err != nil {
+			return nil, err
+		}
+	}
+	query := fmt.Sprintf("select %s from %s where attributes @> $1::jsonb and deleted_at IS NULL order by id limit $2 offset $3", organizationsRows, m.table)
+	var resp []*Organizations
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, string(contained), limit, offset)
+	return resp, err
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// AuditInfo 写入审计日志的调用信息
+type AuditInfo struct {
+	Actor     string // 操作人
+	Action    string // 接口名，如 UpdateOrganization
+	RequestId string // 请求 ID
+}
+
+type auditInfoKey struct{}
+
+// WithAuditInfo 返回携带调用信息的 context，审计事务据此写入审计日志
+func WithAuditInfo(ctx context.Context, info *AuditInfo) context.Context {
+	return context.WithValue(ctx, auditInfoKey{}, info)
+}
+
+// Guard 在变更事务中执行的检查，返回错误时回滚事务；用于在变更之前于同一事务中复核前置条件（如管理授权）
+type Guard func(ctx context.Context, session sqlx.Session) error
+
+type guardsKey struct{}
+
+// WithGuard 返回追加了守卫的 context，之后经由审计事务的变更都会在事务开始时执行全部守卫
+func WithGuard(ctx context.Context, guard Guard) context.Context {
+	guards, _ := ctx.Value(guardsKey{}).([]Guard)
+	return context.WithValue(ctx, guardsKey{}, append(slices.Clip(guards), guard))
+}
+
+// RunGuards 在 session 中依次执行 context 中的守卫，遇到错误即返回
+func RunGuards(ctx context.Context, session sqlx.Session) error {
+	guards, _ := ctx.Value(guardsKey{}).([]Guard)
+	for _, guard := range guards {
+		if err := guard(ctx, session); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transactor 可开启事务的连接，sqlx.SqlConn 与 sqlc.CachedConn 均满足
+type transactor interface {
+	TransactCtx(ctx context.Context, fn func(context.Context, sqlx.Session) error) error
+}
+
+// auditTransact 在事务中执行 fn，事务开始时将 context 中的调用信息设置为事务级配置，由各表上的审计触发器写入 org.audit_log，
+// 随后执行 context 中的守卫；修改组织、成员、职位、管理授权与 Webhook 订阅的事务都应经由此方法，否则审计日志中的操作人、接口与请求 ID 为空
+func auditTransact(ctx context.Context, conn transactor, fn func(ctx context.Context, session sqlx.Session) error) error {
+	return conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		if info, ok := ctx.Value(auditInfoKey{}).(*AuditInfo); ok {
+			query := "select set_config('org.audit_actor', $1, true), set_config('org.audit_action', $2, true), set_config('org.audit_request_id', $3, true)"
+			if _, err := session.ExecCtx(ctx, query, info.Actor, info.Action, info.RequestId); err != nil {
+				return err
+			}
+		}
+		if err := RunGuards(ctx, session); err != nil {
+			return err
+		}
+		return fn(ctx, session)
+	})
+}
+
+// transact 在审计事务中修改组织
+func (m *customOrganizationsModel) transact(ctx context.Context, fn func(ctx context.Context, session sqlx.Session) error) error {
+	return auditTransact(ctx, m, fn)
+}
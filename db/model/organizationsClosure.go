@@ -0,0 +1,113 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// organizationClosureTable 组织闭包表，保存所有 (祖先, 后代, 距离) 关系，包含距离为 0 的自身关系
+const organizationClosureTable = `"org"."organization_closure"`
+
+// IsAncestor 检查 ancestorId 是否为 descendantId 的祖先（不含自身）
+func (m *customOrganizationsModel) IsAncestor(ctx context.Context, ancestorId, descendantId int64) (bool, error) {
+	var ok bool
+	query := fmt.Sprintf("select exists(select 1 from %s where ancestor_id = $1 and descendant_id = $2 and depth > 0)", organizationClosureTable)
+	err := m.QueryRowNoCacheCtx(ctx, &ok, query, ancestorId, descendantId)
+	return ok, err
+}
+
+// CountDescendants 统计未删除的后代组织数量（不含自身）
+func (m *customOrganizationsModel) CountDescendants(ctx context.Context, id int64) (int64, error) {
+	query := fmt.Sprintf(`select count(*) from %s c join %s o on o.id = c.descendant_id
+where c.ancestor_id = $1 and c.depth > 0 and o.deleted_at IS NULL`, organizationClosureTable, m.table)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, id)
+	return count, err
+}
+
+// FindDescendantsWithinDepth 查询距离在 maxDepth 以内的未删除后代组织（不含自身），按层级排序；maxDepth <= 0 表示不限
+func (m *customOrganizationsModel) FindDescendantsWithinDepth(ctx context.Context, id int64, maxDepth int) ([]*Organizations, error) {
+	if maxDepth <= 0 {
+		maxDepth = maxTreeDepth
+	}
+	query := fmt.Sprintf(`select %s from %s c join %s o on o.id = c.descendant_id
+where c.ancestor_id = $1 and c.depth between 1 and $2 and o.deleted_at IS NULL
+order by c.depth, o.sort_order, o.created_at, o.id`, organizationsRowsWithAlias, organizationClosureTable, m.table)
+	var resp []*Organizations
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, id, maxDepth)
+	return resp, err
+}
+
+// RebuildClosure 根据 parent_id 重建闭包表，用于初始化已有数据库或修复不一致；层级超过 maxTreeDepth 时返回 ErrTreeTooDeep
+func (m *customOrganizationsModel) RebuildClosure(ctx context.Context) error {
+	return m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		if _, err := session.ExecCtx(ctx, "select pg_advisory_xact_lock($1)", treeLockKey); err != nil {
+			return err
+		}
+		if _, err := session.ExecCtx(ctx, fmt.Sprintf("delete from %s", organizationClosureTable)); err != nil {
+			return err
+		}
+		query := fmt.Sprintf(`insert into %[1]s (ancestor_id, descendant_id, depth)
+with recursive c as (
+	select id as ancestor_id, id as descendant_id, 0 as depth from %[2]s
+	union all
+	select c.ancestor_id, o.id, c.depth + 1 from c join %[2]s o on o.parent_id = c.descendant_id
+	where c.depth < $1
+)
+select ancestor_id, descendant_id, depth from c`, organizationClosureTable, m.table)
+		// 多展开一层，存在更深的关系（或 parent_id 有环）时回滚，避免写入不完整的闭包表
+		if _, err := session.ExecCtx(ctx, query, maxTreeDepth+1); err != nil {
+			return err
+		}
+		var tooDeep bool
+		query = fmt.Sprintf("select exists(select 1 from %s where depth > $1)", organizationClosureTable)
+		if err := session.QueryRowCtx(ctx, &tooDeep, query, maxTreeDepth); err != nil {
+			return err
+		}
+		if tooDeep {
+			return ErrTreeTooDeep
+		}
+		return nil
+	})
+}
+
+// isAncestor 在给定会话中通过闭包表检查祖先关系（不含自身）
+func (m *customOrganizationsModel) isAncestor(ctx context.Context, session sqlx.Session, ancestorId, descendantId int64) (bool, error) {
+	var ok bool
+	query := fmt.Sprintf("select exists(select 1 from %s where ancestor_id = $1 and descendant_id = $2 and depth > 0)", organizationClosureTable)
+	err := session.QueryRowCtx(ctx, &ok, query, ancestorId, descendantId)
+	return ok, err
+}
+
+// insertClosure 为新插入的组织写入闭包关系：自身关系及父节点所有祖先关系
+func (m *customOrganizationsModel) insertClosure(ctx context.Context, session sqlx.Session, id int64, parentId sql.NullInt64) error {
+	query := fmt.Sprintf(`insert into %[1]s (ancestor_id, descendant_id, depth)
+select ancestor_id, $1::bigint, depth + 1 from %[1]s where descendant_id = $2::bigint
+union all
+select $1::bigint, $1::bigint, 0`, organizationClosureTable)
+	_, err := session.ExecCtx(ctx, query, id, parentId)
+	return err
+}
+
+// moveClosure 将以 id 为根的子树从原祖先链上摘下，再挂到 newParentId 的祖先链上
+func (m *customOrganizationsModel) moveClosure(ctx context.Context, session sqlx.Session, id, newParentId int64) error {
+	query := fmt.Sprintf(`delete from %[1]s
+where descendant_id in (select descendant_id from %[1]s where ancestor_id = $1)
+and ancestor_id not in (select descendant_id from %[1]s where ancestor_id = $1)`, organizationClosureTable)
+	if _, err := session.ExecCtx(ctx, query, id); err != nil {
+		return err
+	}
+	if newParentId == 0 {
+		return nil
+	}
+
+	query = fmt.Sprintf(`insert into %[1]s (ancestor_id, descendant_id, depth)
+select p.ancestor_id, c.descendant_id, p.depth + c.depth + 1
+from %[1]s p cross join %[1]s c
+where p.descendant_id = $2 and c.ancestor_id = $1`, organizationClosureTable)
+	_, err := session.ExecCtx(ctx, query, id, newParentId)
+	return err
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// organizationCodeSeq 编码自动生成使用的序列
+const organizationCodeSeq = `org.organization_code_seq`
+
+// codeRow 组织 ID、编码与物化路径，用于物理删除时清除编码缓存及数据范围缓存
+type codeRow struct {
+	Id   int64  `db:"id"`
+	Code string `db:"code"`
+	Path string `db:"path"`
+}
+
+// FindOneByCode 按编码查询未删除的组织
+// 编码缓存仅保存编码到 ID 的映射（含已删除组织），行数据复用 FindOne 的 ID 缓存，
+// 因此软删除、恢复、禁用等只需清除 ID 缓存，编码缓存仅在编码变更或物理删除时清除
+func (m *customOrganizationsModel) FindOneByCode(ctx context.Context, code string) (*Organizations, error) {
+	orgOrganizationsCodeKey := fmt.Sprintf("%s%v", cacheOrgOrganizationsCodePrefix, code)
+	var id int64
+	err := m.QueryRowCtx(ctx, &id, orgOrganizationsCodeKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		query := fmt.Sprintf("select id from %s where code = $1 limit 1", m.table)
+		return conn.QueryRowCtx(ctx, v, query, code)
+	})
+	switch {
+	case err == nil:
+		return m.FindOne(ctx, id)
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindByCodes 按编码批量查询未删除的组织，结果顺序不保证与入参一致，不存在的编码直接忽略
+// 命中编码缓存的走 FindOne（ID 缓存），其余编码合并为一次查询并回填编码缓存
+func (m *customOrganizationsModel) FindByCodes(ctx context.Context, codes []string) ([]*Organizations, error) {
+	resp := make([]*Organizations, 0, len(codes))
+	missed := make([]string, 0, len(codes))
+	for _, code := range codes {
+		var id int64
+		if err := m.GetCacheCtx(ctx, fmt.Sprintf("%s%v", cacheOrgOrganizationsCodePrefix, code), &id); err != nil {
+			missed = append(missed, code)
+			continue
+		}
+		org, err := m.FindOne(ctx, id)
+		switch {
+		case err == nil:
+			resp = append(resp, org)
+		case errors.Is(err, ErrNotFound):
+		default:
+			return nil, err
+		}
+	}
+	if len(missed) == 0 {
+		return resp, nil
+	}
+
+	query := fmt.Sprintf("select %s from %s where code = any($1)", organizationsRows, m.table)
+	var rows []*Organizations
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, pq.Array(missed)); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		// 回填失败不影响本次结果，下次查询会再次回源
+		_ = m.SetCacheCtx(ctx, fmt.Sprintf("%s%v", cacheOrgOrganizationsCodePrefix, row.Code), row.Id)
+		if !row.DeletedAt.Valid {
+			resp = append(resp, row)
+		}
+	}
+	return resp, nil
+}
+
+// ExistsByCode 检查编码是否已被占用，已删除组织的编码同样视为占用，以便恢复时不冲突
+func (m *customOrganizationsModel) ExistsByCode(ctx context.Context, code string) (bool, error) {
+	query := fmt.Sprintf("select exists(select 1 from %s where code = $1)", m.table)
+	var exists bool
+	err := m.QueryRowNoCacheCtx(ctx, &exists, query, code)
+	return exists, err
+}
+
+// NextCodeSeq 从序列 org.organization_code_seq 获取下一个编码序号
+func (m *customOrganizationsModel) NextCodeSeq(ctx context.Context) (int64, error) {
+	var seq int64
+	err := m.QueryRowNoCacheCtx(ctx, &seq, fmt.Sprintf("select nextval('%s')", organizationCodeSeq))
+	return seq, err
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+)
+
+// organizationHistoryTable 组织历史版本表，由 org.organizations 上的触发器维护
+const organizationHistoryTable = `"org"."organization_history"`
+
+// snapshotSource 返回查询组织数据的来源：asOf 为零值时为当前表，snapshot 为空；
+// 否则将 asOf 追加到 args，snapshot 为名为 snapshot 的公用表表达式，内容为 asOf 时刻有效的历史版本，列与当前表一致
+func (m *customOrganizationsModel) snapshotSource(asOf time.Time, args *[]any) (snapshot, table string) {
+	if asOf.IsZero() {
+		return "", m.table
+	}
+	*args = append(*args, asOf)
+	snapshot = fmt.Sprintf("snapshot as (select %[1]s from %[2]s where valid_from <= $%[3]d and (valid_to IS NULL or valid_to > $%[3]d))",
+		organizationsRows, organizationHistoryTable, len(*args))
+	return snapshot, "snapshot"
+}
+
+// withClause 非递归查询的 with 子句
+func withClause(snapshot string) string {
+	if snapshot == "" {
+		return ""
+	}
+	return "with " + snapshot + " "
+}
+
+// withRecursive 递归查询的 with recursive 子句，其后紧跟递归的公用表表达式
+func withRecursive(snapshot string) string {
+	if snapshot == "" {
+		return "with recursive "
+	}
+	return "with recursive " + snapshot + ", "
+}
+
+// FindOneAsOf 查询 asOf 时刻未删除的组织；asOf 时组织尚未创建、已删除或已物理删除时返回 ErrNotFound
+func (m *customOrganizationsModel) FindOneAsOf(ctx context.Context, id int64, asOf time.Time) (*Organizations, error) {
+	args := []any{id}
+	snapshot, table := m.snapshotSource(asOf, &args)
+	query := fmt.Sprintf("%sselect %s from %s where id = $1 and deleted_at IS NULL limit 1", withClause(snapshot), organizationsRows, table)
+	var resp Organizations
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, args...)
+	switch {
+	case err == nil:
+		return &resp, nil
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindAncestorsAsOf 查询 asOf 时刻的祖先链（从根到自身），按 asOf 时的父子关系遍历
+func (m *customOrganizationsModel) FindAncestorsAsOf(ctx context.Context, id int64, asOf time.Time) ([]*Organizations, error) {
+	return m.findAncestors(ctx, id, asOf)
+}
@@ -0,0 +1,210 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSnapshotSource(t *testing.T) {
+	m := &customOrganizationsModel{defaultOrganizationsModel: &defaultOrganizationsModel{table: `"org"."organizations"`}}
+
+	args := []any{int64(1)}
+	snapshot, table := m.snapshotSource(time.Time{}, &args)
+	if snapshot != "" || table != m.table || len(args) != 1 {
+		t.Fatalf("current: snapshot=%q table=%s args=%v", snapshot, table, args)
+	}
+	if withClause(snapshot) != "" || withRecursive(snapshot) != "with recursive " {
+		t.Fatalf("current: with clauses %q, %q", withClause(snapshot), withRecursive(snapshot))
+	}
+
+	asOf := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshot, table = m.snapshotSource(asOf, &args)
+	if table != "snapshot" || len(args) != 2 || args[1] != asOf {
+		t.Fatalf("as of: table=%s args=%v", table, args)
+	}
+	// asOf 为追加后的最后一个参数
+	if !strings.Contains(snapshot, "valid_from <= $2 and (valid_to IS NULL or valid_to > $2)") {
+		t.Errorf("as of: unexpected snapshot %q", snapshot)
+	}
+	if !strings.HasPrefix(withClause(snapshot), "with snapshot as (") || !strings.HasSuffix(withRecursive(snapshot), "), ") {
+		t.Errorf("as of: with clauses %q, %q", withClause(snapshot), withRecursive(snapshot))
+	}
+}
+
+// TestOrganizationHistory 依次改名、移动、禁用、删除组织，按各步骤之后的时刻查询历史版本
+func TestOrganizationHistory(t *testing.T) {
+	conn := newTestConn(t)
+	m := NewOrganizationsModel(conn, testCacheConf)
+	ctx := context.Background()
+
+	prefix := fmt.Sprintf("history-%d", time.Now().UnixNano())
+	// 以数据库时钟为准，晚于之前已提交事务的开始时间
+	now := func() time.Time {
+		t.Helper()
+		var ts time.Time
+		if err := conn.QueryRowCtx(ctx, &ts, "select clock_timestamp()"); err != nil {
+			t.Fatal(err)
+		}
+		return ts
+	}
+
+	rootId := insertTestOrg(t, ctx, m, prefix, prefix, 0)
+	aId := insertTestOrg(t, ctx, m, prefix, "a", rootId)
+	bId := insertTestOrg(t, ctx, m, prefix, "b", rootId)
+	cleanupTestOrgs(t, conn, rootId, aId, bId)
+	created := now()
+
+	a, err := m.FindOne(ctx, aId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Name, a.NameKey = "a2", "a2"
+	if err := m.Update(ctx, a); err != nil {
+		t.Fatal(err)
+	}
+	renamed := now()
+
+	if err := m.Move(ctx, aId, bId, Placement{}); err != nil {
+		t.Fatal(err)
+	}
+	moved := now()
+
+	if err := m.Disable(ctx, aId); err != nil {
+		t.Fatal(err)
+	}
+	disabled := now()
+
+	if err := m.SoftDelete(ctx, aId); err != nil {
+		t.Fatal(err)
+	}
+	deleted := now()
+
+	if err := m.Delete(ctx, rootId); err != nil {
+		t.Fatal(err)
+	}
+	purged := now()
+
+	t.Run("versions", func(t *testing.T) {
+		// 同一事务中的多次变更合并为一个版本，各版本首尾相接；物理删除后不再有当前版本
+		var versions []struct {
+			ValidFrom time.Time    `db:"valid_from"`
+			ValidTo   sql.NullTime `db:"valid_to"`
+		}
+		err := conn.QueryRowsCtx(ctx, &versions, `select valid_from, valid_to from org.organization_history where id = $1 order by valid_from`, aId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 创建、改名、移动、禁用、删除
+		if len(versions) != 5 {
+			t.Fatalf("versions = %d, want 5", len(versions))
+		}
+		for i, v := range versions {
+			if !v.ValidTo.Valid {
+				t.Fatalf("version %d is still current after purge", i)
+			}
+			if !v.ValidTo.Time.After(v.ValidFrom) {
+				t.Errorf("version %d: valid_to %v not after valid_from %v", i, v.ValidTo.Time, v.ValidFrom)
+			}
+			if i > 0 && !versions[i-1].ValidTo.Time.Equal(v.ValidFrom) {
+				t.Errorf("version %d starts at %v, previous ends at %v", i, v.ValidFrom, versions[i-1].ValidTo.Time)
+			}
+		}
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		for _, tt := range []struct {
+			asOf time.Time
+			name string
+		}{{created, "a"}, {renamed, "a2"}, {disabled, "a2"}} {
+			org, err := m.FindOneAsOf(ctx, aId, tt.asOf)
+			if err != nil || org.Name != tt.name {
+				t.Errorf("FindOneAsOf(%v) = %v, %v; want name %s", tt.asOf, org, err, tt.name)
+			}
+		}
+	})
+
+	t.Run("move", func(t *testing.T) {
+		for _, tt := range []struct {
+			asOf time.Time
+			want []int64
+		}{{renamed, []int64{rootId, aId}}, {moved, []int64{rootId, bId, aId}}} {
+			ancestors, err := m.FindAncestorsAsOf(ctx, aId, tt.asOf)
+			if err != nil || !slices.Equal(idsOf(ancestors), tt.want) {
+				t.Errorf("FindAncestorsAsOf(%v) = %v, %v; want %v", tt.asOf, idsOf(ancestors), err, tt.want)
+			}
+		}
+
+		tree, err := m.FindDescendantsTree(ctx, rootId, DescendantsOptions{AsOf: moved})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tree.Children) != 1 || tree.Children[0].Id != bId || len(tree.Children[0].Children) != 1 || tree.Children[0].Children[0].Id != aId {
+			t.Errorf("descendants after move: want %d -> %d -> %d", rootId, bId, aId)
+		}
+
+		for _, tt := range []struct {
+			parentId int64
+			asOf     time.Time
+			want     []int64
+		}{{rootId, renamed, []int64{aId, bId}}, {rootId, moved, []int64{bId}}, {bId, moved, []int64{aId}}} {
+			filter := &OrganizationsFilter{ParentId: tt.parentId, AsOf: tt.asOf}
+			page, err := m.FindPageByFilter(ctx, filter, 10, 0)
+			if err != nil || !slices.Equal(idsOf(page), tt.want) {
+				t.Errorf("FindPageByFilter(parent %d, %v) = %v, %v; want %v", tt.parentId, tt.asOf, idsOf(page), err, tt.want)
+			}
+			count, err := m.CountByFilter(ctx, filter)
+			if err != nil || count != int64(len(tt.want)) {
+				t.Errorf("CountByFilter(parent %d, %v) = %d, %v; want %d", tt.parentId, tt.asOf, count, err, len(tt.want))
+			}
+		}
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		org, err := m.FindOneAsOf(ctx, aId, disabled)
+		if err != nil || !org.DisabledAt.Valid {
+			t.Errorf("FindOneAsOf(disabled) = %v, %v; want disabled", org, err)
+		}
+		for _, tt := range []struct {
+			activeOnly bool
+			want       int
+		}{{true, 0}, {false, 1}} {
+			tree, err := m.FindDescendantsTree(ctx, bId, DescendantsOptions{ActiveOnly: tt.activeOnly, AsOf: disabled})
+			if err != nil || len(tree.Children) != tt.want {
+				t.Errorf("FindDescendantsTree(activeOnly=%v) children = %v, %v; want %d", tt.activeOnly, tree, err, tt.want)
+			}
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if _, err := m.FindOneAsOf(ctx, aId, deleted); !errors.Is(err, ErrNotFound) {
+			t.Errorf("FindOneAsOf(deleted) err = %v; want ErrNotFound", err)
+		}
+		page, err := m.FindPageByFilter(ctx, &OrganizationsFilter{ParentId: bId, IncludeDeleted: true, AsOf: deleted}, 10, 0)
+		if err != nil || !slices.Equal(idsOf(page), []int64{aId}) {
+			t.Errorf("FindPageByFilter(include deleted) = %v, %v; want [%d]", idsOf(page), err, aId)
+		}
+
+		// 物理删除后当前表中已无数据，删除之前的时刻仍可查询
+		if _, err := m.FindOneAsOf(ctx, rootId, purged); !errors.Is(err, ErrNotFound) {
+			t.Errorf("FindOneAsOf(purged) err = %v; want ErrNotFound", err)
+		}
+		if _, err := m.FindOneAsOf(ctx, rootId, deleted); err != nil {
+			t.Errorf("FindOneAsOf(before purge) err = %v", err)
+		}
+	})
+}
+
+// idsOf 提取组织 ID
+func idsOf(orgs []*Organizations) []int64 {
+	ids := make([]int64, 0, len(orgs))
+	for _, org := range orgs {
+		ids = append(ids, org.Id)
+	}
+	return ids
+}
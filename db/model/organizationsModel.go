@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"github.com/lib/pq"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"strings"
 	"time"
@@ -43,8 +44,9 @@ type (
 		FindByParentId(ctx context.Context, parentId int64) ([]*Organizations, error)       // 查询子组织
 		FindActiveByParentId(ctx context.Context, parentId int64) ([]*Organizations, error) // 查询活跃子组织
 
-		FindDescendantsById(ctx context.Context, id int64) (*OrganizationsTree, error)       // 查询所有后代组织
-		FindActiveDescendantsById(ctx context.Context, id int64) (*OrganizationsTree, error) // 查询所有活跃后代组织
+		FindDescendantsById(ctx context.Context, id int64) (*OrganizationsTree, error)                          // 查询所有后代组织
+		FindActiveDescendantsById(ctx context.Context, id int64) (*OrganizationsTree, error)                    // 查询所有活跃后代组织
+		FindDescendantsTree(ctx context.Context, id int64, opts DescendantsOptions) (*OrganizationsTree, error) // 按选项查询后代组织树
 
 		SoftDelete(ctx context.Context, id int64) error         // 软删除组织
 		Restore(ctx context.Context, id int64) error            // 恢复已删除组织
@@ -52,6 +54,44 @@ type (
 		Enable(ctx context.Context, id int64) error             // 启用组织
 		BatchSoftDelete(ctx context.Context, ids []int64) error // 批量软删除
 		BatchDisable(ctx context.Context, ids []int64) error    // 批量禁用
+
+		DisableNode(ctx context.Context, id int64, cascade bool) (int64, error)   // 禁用组织，可级联整棵子树
+		EnableNode(ctx context.Context, id int64, cascade bool) (int64, error)    // 启用组织，可级联整棵子树
+		RestoreNode(ctx context.Context, id int64, cascade bool) (int64, error)   // 恢复已删除组织，可级联整棵子树
+		DeleteNode(ctx context.Context, id int64, mode DeleteMode) (int64, error) // 按删除方式软删除组织
+
+		CountByFilter(ctx context.Context, filter *OrganizationsFilter) (int64, error)                                    // 按条件统计组织数量
+		FindPageByFilter(ctx context.Context, filter *OrganizationsFilter, limit, offset int64) ([]*Organizations, error) // 按条件分页查询组织
+
+		IsAncestor(ctx context.Context, ancestorId, descendantId int64) (bool, error)                     // 检查是否为祖先关系（不含自身）
+		CountDescendants(ctx context.Context, id int64) (int64, error)                                    // 统计后代组织数量
+		FindDescendantsWithinDepth(ctx context.Context, id int64, maxDepth int) ([]*Organizations, error) // 查询指定距离内的后代组织
+		RebuildClosure(ctx context.Context) error                                                         // 根据 parent_id 重建闭包表
+		RebuildNameKeys(ctx context.Context, normalize func(name string) string) (int64, error)           // 按名称规范化规则重新生成 name_key
+
+		FindSubtreeByPath(ctx context.Context, path string) ([]*Organizations, error)          // 按物化路径前缀查询子树（含自身）
+		FindByPathDepth(ctx context.Context, path string, depth int) ([]*Organizations, error) // 查询路径前缀下指定层级的组织
+		ValidateParent(ctx context.Context, id, parentId int64) error                          // 验证父级关系（防止循环引用）
+		Move(ctx context.Context, id, newParentId int64, p Placement) error                    // 移动组织到新的父节点下的指定位置
+
+		ExistsByName(ctx context.Context, parentId int64, nameKey string, excludeId int64) (bool, error) // 检查同级规范化名称是否存在（排除指定ID）
+
+		InsertAt(ctx context.Context, data *Organizations, p Placement) (sql.Result, error) // 在兄弟节点中的指定位置插入组织
+		ReorderChildren(ctx context.Context, parentId int64, orderedIds []int64) error      // 按给定顺序重排全部子组织
+
+		FindByCodes(ctx context.Context, codes []string) ([]*Organizations, error) // 按编码批量查询未删除的组织，优先读取缓存
+		ExistsByCode(ctx context.Context, code string) (bool, error)               // 检查编码是否已被占用（含已删除组织）
+		NextCodeSeq(ctx context.Context) (int64, error)                            // 获取编码自动生成使用的序列号
+
+		FindByAttributes(ctx context.Context, attrs map[string]any, limit, offset int64) ([]*Organizations, error) // 查询扩展属性包含指定键值的未删除组织
+
+		FindSubtreeIds(ctx context.Context, id int64) ([]int64, error)     // 查询组织自身及全部未删除后代的 ID（数据范围），优先读取缓存
+		FindExistingIds(ctx context.Context, ids []int64) ([]int64, error) // 过滤出给定 ID 中未删除的组织，去重并升序
+
+		FindWatchSnapshot(ctx context.Context, rootId int64, settle time.Duration) (*WatchSnapshot, error) // 查询订阅组织树时的子树快照及对应的发件箱版本
+
+		FindOneAsOf(ctx context.Context, id int64, asOf time.Time) (*Organizations, error)         // 查询 asOf 时刻未删除的组织
+		FindAncestorsAsOf(ctx context.Context, id int64, asOf time.Time) ([]*Organizations, error) // 查询 asOf 时刻的祖先链（从根到自身）
 		/*
 			TODO: 根据表结构和索引优化，添加以下业务方法
 
@@ -72,11 +112,6 @@ type (
 			FindWithPagination(ctx context.Context, page, pageSize int64) ([]*Organizations, int64, error) // 分页查询
 			FindActiveWithPagination(ctx context.Context, page, pageSize int64) ([]*Organizations, int64, error) // 分页查询活跃组织
 
-			// 验证方法
-			ExistsByName(ctx context.Context, name string, excludeId int64) (bool, error)          // 检查名称是否存在（排除指定ID）
-			IsAncestor(ctx context.Context, ancestorId, descendantId int64) (bool, error)          // 检查是否为祖先关系
-			ValidateParent(ctx context.Context, id, parentId int64) error                          // 验证父级关系（防止循环引用）
-
 			// 搜索方法
 			SearchByName(ctx context.Context, keyword string, limit int64) ([]*Organizations, error) // 按名称模糊搜索
 			FindByTimeRange(ctx context.Context, startTime, endTime string) ([]*Organizations, error) // 按时间范围查询
@@ -93,8 +128,68 @@ type (
 		*Organizations
 		Children []*OrganizationsTree
 	}
+
+	// ancestorRow 祖先链递归查询的结果行
+	ancestorRow struct {
+		*Organizations
+		Depth int  `db:"depth"` // 距起始节点的层数
+		Cycle bool `db:"cycle"` // 是否在此处检测到环
+	}
+
+	// DescendantsOptions 后代树查询选项
+	DescendantsOptions struct {
+		MaxDepth   int       // 最大深度（根节点为 0）；<=0 表示不限
+		ActiveOnly bool      // 仅包含活跃组织（未禁用）
+		AsOf       time.Time // 查询该时刻的组织树；零值表示当前
+	}
+
+	// OrganizationsFilter 组织列表查询条件
+	OrganizationsFilter struct {
+		ParentId        int64     // 父节点 ID；0 表示根节点
+		IncludeDisabled bool      // 是否包含已禁用组织
+		IncludeDeleted  bool      // 是否包含已软删除组织
+		NamePrefix      string    // 名称前缀；空表示不过滤
+		SortField       string    // 排序字段，见 organizationsSortFields
+		Desc            bool      // 是否倒序
+		AsOf            time.Time // 查询该时刻的组织；零值表示当前
+	}
 )
 
+// 排序字段常量
+const (
+	SortFieldSortOrder = "sort_order"
+	SortFieldCreatedAt = "created_at"
+	SortFieldName      = "name"
+	SortFieldUpdatedAt = "updated_at"
+)
+
+// organizationsSortFields 允许排序的字段白名单，防止 SQL 注入
+var organizationsSortFields = map[string]struct{}{
+	SortFieldSortOrder: {},
+	SortFieldCreatedAt: {},
+	SortFieldName:      {},
+	SortFieldUpdatedAt: {},
+}
+
+// siblingNameIndex 同级名称唯一索引名
+const siblingNameIndex = "uk_org_sibling_name"
+
+// codeIndex 组织编码唯一索引名
+const codeIndex = "uk_org_code"
+
+// maxTreeDepth 树遍历的最大深度，防止异常数据导致无限递归
+const maxTreeDepth = 256
+
+// organizationsRowsWithAlias 带 o. 表别名的查询列，用于自连接查询
+var organizationsRowsWithAlias = "o." + strings.Join(organizationsFieldNames, ",o.")
+
+// treeLockKey 树结构变更使用的事务级 advisory lock：移动加排他锁以避免并发形成环，
+// 插入加共享锁以保证读取到的父节点闭包关系不会被并发移动改写
+const treeLockKey = 0x6f7267 // "org"
+
+// likeEscaper 转义 LIKE 通配符
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 // NewOrganizationsModel returns a model for the database table.
 func NewOrganizationsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) OrganizationsModel {
 	return &customOrganizationsModel{
@@ -102,28 +197,43 @@ func NewOrganizationsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.O
 	}
 }
 
-// FindAncestorsById 查找指定组织的所有祖先组织（从根到父级）
+// FindAncestorsById 通过一次递归查询获取指定组织的祖先链（从根到自身）
+// 遍历过程中检测循环引用，发现环时返回 *CycleError，超过 maxTreeDepth 时返回 ErrTreeTooDeep
 func (m *customOrganizationsModel) FindAncestorsById(ctx context.Context, id int64) ([]*Organizations, error) {
-	var ancestors []*Organizations
-
-	curID := id
-	for {
-		org, err := m.FindById(ctx, curID)
-		if err != nil {
-			return nil, err
-		}
+	return m.findAncestors(ctx, id, time.Time{})
+}
 
-		// 追加到末尾，最后再反转即可
-		ancestors = append(ancestors, org)
+// findAncestors 查询 asOf 时刻的祖先链，asOf 为零值表示当前
+func (m *customOrganizationsModel) findAncestors(ctx context.Context, id int64, asOf time.Time) ([]*Organizations, error) {
+	args := []any{id, maxTreeDepth}
+	snapshot, table := m.snapshotSource(asOf, &args)
+	query := fmt.Sprintf(`%[4]schain as (
+	select %[2]s, 0 as depth, array[id] as path, false as cycle from %[1]s where id = $1 and deleted_at IS NULL
+	union all
+	select %[3]s, c.depth + 1, c.path || o.id, o.id = any(c.path) from %[1]s o join chain c on o.id = c.parent_id
+	where not c.cycle and c.depth < $2
+)
+select %[2]s, depth, cycle from chain order by depth desc`, table, organizationsRows, organizationsRowsWithAlias, withRecursive(snapshot))
+	var rows []*ancestorRow
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrNotFound
+	}
 
-		if !org.ParentId.Valid {
-			break
-		}
-		curID = org.ParentId.Int64
+	// rows[0] 为深度最大的一行，即最靠近根的祖先
+	top := rows[0]
+	if top.Cycle {
+		return nil, &CycleError{Id: top.Id}
+	}
+	if top.ParentId.Valid && top.Depth >= maxTreeDepth {
+		return nil, ErrTreeTooDeep
 	}
 
-	for i, j := 0, len(ancestors)-1; i < j; i, j = i+1, j-1 {
-		ancestors[i], ancestors[j] = ancestors[j], ancestors[i]
+	ancestors := make([]*Organizations, 0, len(rows))
+	for _, row := range rows {
+		ancestors = append(ancestors, row.Organizations)
 	}
 	return ancestors, nil
 }
@@ -176,7 +286,7 @@ func (m *customOrganizationsModel) FindActiveByName(ctx context.Context, name st
 
 // FindByParentId 查询子组织 (未删除)
 func (m *customOrganizationsModel) FindByParentId(ctx context.Context, parentId int64) ([]*Organizations, error) {
-	query := fmt.Sprintf("select %s from %s where parent_id = $1 and deleted_at IS NULL order by created_at", organizationsRows, m.table)
+	query := fmt.Sprintf("select %s from %s where parent_id = $1 and deleted_at IS NULL order by sort_order, created_at", organizationsRows, m.table)
 	var resp []*Organizations
 	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, parentId)
 	return resp, err
@@ -184,7 +294,7 @@ func (m *customOrganizationsModel) FindByParentId(ctx context.Context, parentId
 
 // FindActiveByParentId 查询活跃子组织 (未删除且未禁用)
 func (m *customOrganizationsModel) FindActiveByParentId(ctx context.Context, parentId int64) ([]*Organizations, error) {
-	query := fmt.Sprintf("select %s from %s where parent_id = $1 and deleted_at IS NULL and disabled_at IS NULL order by created_at", organizationsRows, m.table)
+	query := fmt.Sprintf("select %s from %s where parent_id = $1 and deleted_at IS NULL and disabled_at IS NULL order by sort_order, created_at", organizationsRows, m.table)
 	var resp []*Organizations
 	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, parentId)
 	return resp, err
@@ -192,166 +302,187 @@ func (m *customOrganizationsModel) FindActiveByParentId(ctx context.Context, par
 
 // FindDescendantsById 查询组织所有后代组织并构建树形结构
 func (m *customOrganizationsModel) FindDescendantsById(ctx context.Context, id int64) (*OrganizationsTree, error) {
-	// 首先根据id查询组织
-	org, err := m.FindById(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-
-	// 创建组织树根节点
-	tree := &OrganizationsTree{
-		Organizations: org,
-		Children:      []*OrganizationsTree{},
-	}
-
-	// 递归构建子树
-	var buildDescendantsTree func(*OrganizationsTree) error
-	buildDescendantsTree = func(node *OrganizationsTree) error {
-		// 根据当前节点ID查询子组织
-		children, err := m.FindByParentId(ctx, node.Id)
-		if err != nil {
-			return err
-		}
+	return m.FindDescendantsTree(ctx, id, DescendantsOptions{})
+}
 
-		// 为每个子组织创建树节点
-		for _, child := range children {
-			childNode := &OrganizationsTree{
-				Organizations: child,
-				Children:      []*OrganizationsTree{},
-			}
-			node.Children = append(node.Children, childNode)
+// FindActiveDescendantsById 获取指定组织及其子组织
+func (m *customOrganizationsModel) FindActiveDescendantsById(ctx context.Context, id int64) (*OrganizationsTree, error) {
+	return m.FindDescendantsTree(ctx, id, DescendantsOptions{ActiveOnly: true})
+}
 
-			// 递归构建子树
-			if err := buildDescendantsTree(childNode); err != nil {
-				return err
-			}
-		}
-		return nil
+// FindDescendantsTree 通过一次递归查询加载后代组织，并在内存中构建树形结构
+// 未指定 MaxDepth 或超过 maxTreeDepth 时，组织树深于 maxTreeDepth 返回 ErrTreeTooDeep
+func (m *customOrganizationsModel) FindDescendantsTree(ctx context.Context, id int64, opts DescendantsOptions) (*OrganizationsTree, error) {
+	rootCond, childCond := "deleted_at IS NULL", "o.deleted_at IS NULL"
+	if opts.ActiveOnly {
+		rootCond += " and disabled_at IS NULL"
+		childCond += " and o.disabled_at IS NULL"
+	}
+	// 未限制深度或超出遍历上限时多查一层，用于判断组织树是否超过 maxTreeDepth
+	maxDepth, limited := opts.MaxDepth, opts.MaxDepth > 0 && opts.MaxDepth <= maxTreeDepth
+	if !limited {
+		maxDepth = maxTreeDepth + 1
 	}
 
-	// 开始递归构建
-	if err := buildDescendantsTree(tree); err != nil {
+	args := []any{id, maxDepth}
+	snapshot, table := m.snapshotSource(opts.AsOf, &args)
+	query := fmt.Sprintf(`%[6]stree as (
+	select %[2]s, 0 as depth from %[1]s where id = $1 and %[3]s
+	union all
+	select %[4]s, t.depth + 1 from %[1]s o join tree t on o.parent_id = t.id
+	where t.depth < $2 and %[5]s
+)
+select %[2]s from tree order by depth, sort_order, created_at, id`,
+		table, organizationsRows, rootCond, organizationsRowsWithAlias, childCond, withRecursive(snapshot))
+	var rows []*Organizations
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, args...); err != nil {
 		return nil, err
 	}
-
-	return tree, nil
+	if len(rows) == 0 {
+		return nil, ErrNotFound
+	}
+	if !limited && treeDepth(rows) > maxTreeDepth {
+		return nil, ErrTreeTooDeep
+	}
+	return buildOrganizationsTree(rows), nil
 }
 
-// FindActiveDescendantsById 获取指定组织及其子组织
-func (m *customOrganizationsModel) FindActiveDescendantsById(ctx context.Context, id int64) (*OrganizationsTree, error) {
-	// 首先根据id查询活跃组织
-	org, err := m.FindActiveById(ctx, id)
-	if err != nil {
-		return nil, err
+// treeDepth 按层级排序的组织列表的最大深度，rows[0] 为根节点
+func treeDepth(rows []*Organizations) int {
+	depths := make(map[int64]int, len(rows))
+	depth := 0
+	for i, row := range rows {
+		if i > 0 {
+			depth = depths[row.ParentId.Int64] + 1
+		}
+		depths[row.Id] = depth
 	}
+	return depth
+}
 
-	// 创建组织树根节点
-	tree := &OrganizationsTree{
-		Organizations: org,
+// buildOrganizationsTree 将按层级排序的组织列表组装为树，rows[0] 为根节点
+func buildOrganizationsTree(rows []*Organizations) *OrganizationsTree {
+	nodes := make(map[int64]*OrganizationsTree, len(rows))
+	root := &OrganizationsTree{
+		Organizations: rows[0],
 		Children:      []*OrganizationsTree{},
 	}
-
-	// 递归构建子树
-	var buildDescendantsTree func(*OrganizationsTree) error
-	buildDescendantsTree = func(node *OrganizationsTree) error {
-		// 根据当前节点ID查询活跃子组织
-		children, err := m.FindActiveByParentId(ctx, node.Id)
-		if err != nil {
-			return err
+	nodes[root.Id] = root
+	for _, row := range rows[1:] {
+		parent, ok := nodes[row.ParentId.Int64]
+		if !ok {
+			continue
 		}
-
-		// 为每个子组织创建树节点
-		for _, child := range children {
-			childNode := &OrganizationsTree{
-				Organizations: child,
-				Children:      []*OrganizationsTree{},
-			}
-			node.Children = append(node.Children, childNode)
-
-			// 递归构建子树
-			if err := buildDescendantsTree(childNode); err != nil {
-				return err
-			}
+		node := &OrganizationsTree{
+			Organizations: row,
+			Children:      []*OrganizationsTree{},
 		}
-		return nil
-	}
-
-	// 开始递归构建
-	if err := buildDescendantsTree(tree); err != nil {
-		return nil, err
+		parent.Children = append(parent.Children, node)
+		nodes[row.Id] = node
 	}
-
-	return tree, nil
+	return root
 }
 
-// SoftDelete 软删除组织
+// SoftDelete 软删除组织（不处理子组织），同时结束其成员关系
 func (m *customOrganizationsModel) SoftDelete(ctx context.Context, id int64) error {
-	one, err := m.FindOne(ctx, id)
+	deleted, err := m.softDeleteIds(ctx, []int64{id})
 	if err != nil {
 		return err
 	}
-	one.DeletedAt.Valid = true
-	one.DeletedAt.Time = time.Now()
-	err = m.Update(ctx, one)
-	return err
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
 }
 
 // Restore 恢复已删除组织
 func (m *customOrganizationsModel) Restore(ctx context.Context, id int64) error {
-	orgOrganizationsIdKey := fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, id)
-	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set deleted_at = NULL where id = $1", m.table)
-		return conn.ExecCtx(ctx, query, id)
-	}, orgOrganizationsIdKey)
-	return err
+	var paths []string
+	err := m.transact(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("update %s set deleted_at = NULL where id = $1 and deleted_at IS NOT NULL returning path", m.table)
+		if err := session.QueryRowsCtx(ctx, &paths, query, id); err != nil {
+			return err
+		}
+		if len(paths) == 0 {
+			return nil
+		}
+		return recordChanges(ctx, session, ChangeRestored, id)
+	})
+	if err != nil {
+		return translateError(err)
+	}
+	// 恢复的组织重新计入各祖先的数据范围
+	keys := append(scopeCacheKeys(paths...), fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, id))
+	return m.DelCacheCtx(ctx, keys...)
 }
 
 // Disable 禁用组织
 func (m *customOrganizationsModel) Disable(ctx context.Context, id int64) error {
-	orgOrganizationsIdKey := fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, id)
-	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set disabled_at = NOW() where id = $1 and deleted_at IS NULL and disabled_at IS NULL", m.table)
-		return conn.ExecCtx(ctx, query, id)
-	}, orgOrganizationsIdKey)
-	return err
+	return m.BatchDisable(ctx, []int64{id})
 }
 
 // Enable 启用组织
 func (m *customOrganizationsModel) Enable(ctx context.Context, id int64) error {
-	orgOrganizationsIdKey := fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, id)
-	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set disabled_at = NULL where id = $1 and deleted_at IS NULL", m.table)
-		return conn.ExecCtx(ctx, query, id)
-	}, orgOrganizationsIdKey)
-	return err
+	err := m.transact(ctx, func(ctx context.Context, session sqlx.Session) error {
+		var enabled []int64
+		query := fmt.Sprintf("update %s set disabled_at = NULL where id = $1 and deleted_at IS NULL and disabled_at IS NOT NULL returning id", m.table)
+		if err := session.QueryRowsCtx(ctx, &enabled, query, id); err != nil {
+			return err
+		}
+		return recordChanges(ctx, session, ChangeEnabled, enabled...)
+	})
+	if err != nil {
+		return err
+	}
+	return m.DelCacheCtx(ctx, fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, id))
 }
 
-// BatchSoftDelete 批量软删除
+// BatchSoftDelete 批量软删除（不处理子组织），同时结束其成员关系
 func (m *customOrganizationsModel) BatchSoftDelete(ctx context.Context, ids []int64) error {
 	if len(ids) == 0 {
 		return nil
 	}
+	_, err := m.softDeleteIds(ctx, ids)
+	return err
+}
 
-	// 构建占位符
-	placeholders := make([]string, len(ids))
-	args := make([]interface{}, len(ids))
-	for i, id := range ids {
-		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = id
+// softDeleteIds 在一个事务中软删除指定组织并结束其成员关系，返回实际删除的数量
+// 软删除同时清除 disabled_at，以满足 chk_deleted_not_disabled 约束
+func (m *customOrganizationsModel) softDeleteIds(ctx context.Context, ids []int64) (int64, error) {
+	var (
+		deleted       []*pathRow
+		membershipIds []int64
+	)
+	err := m.transact(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("update %s set deleted_at = NOW(), disabled_at = NULL where id = any($1) and deleted_at IS NULL returning id, path", m.table)
+		if err := session.QueryRowsCtx(ctx, &deleted, query, pq.Array(ids)); err != nil {
+			return err
+		}
+		deletedIds := make([]int64, 0, len(deleted))
+		for _, row := range deleted {
+			deletedIds = append(deletedIds, row.Id)
+		}
+		if err := recordChanges(ctx, session, ChangeDeleted, deletedIds...); err != nil {
+			return err
+		}
+		query = fmt.Sprintf("update %s set left_at = NOW() where organization_id = any($1) and left_at IS NULL returning id", membershipsTable)
+		return session.QueryRowsCtx(ctx, &membershipIds, query, pq.Array(deletedIds))
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	// 清除相关缓存
-	keys := make([]string, len(ids))
-	for i, id := range ids {
-		keys[i] = fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, id)
+	keys := make([]string, 0, 2*len(deleted)+len(membershipIds))
+	paths := make([]string, 0, len(deleted))
+	for _, row := range deleted {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, row.Id))
+		paths = append(paths, row.Path)
 	}
-
-	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set deleted_at = NOW() where id IN (%s) and deleted_at IS NULL",
-			m.table, strings.Join(placeholders, ","))
-		return conn.ExecCtx(ctx, query, args...)
-	}, keys...)
-	return err
+	for _, id := range membershipIds {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheOrgMembershipsIdPrefix, id))
+	}
+	keys = append(keys, scopeCacheKeys(paths...)...)
+	return int64(len(deleted)), m.DelCacheCtx(ctx, keys...)
 }
 
 // BatchDisable 批量禁用
@@ -374,37 +505,386 @@ func (m *customOrganizationsModel) BatchDisable(ctx context.Context, ids []int64
 		keys[i] = fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, id)
 	}
 
-	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("update %s set disabled_at = NOW() where id IN (%s) and deleted_at IS NULL and disabled_at IS NULL",
+	err := m.transact(ctx, func(ctx context.Context, session sqlx.Session) error {
+		var disabled []int64
+		query := fmt.Sprintf("update %s set disabled_at = NOW() where id IN (%s) and deleted_at IS NULL and disabled_at IS NULL returning id",
 			m.table, strings.Join(placeholders, ","))
-		return conn.ExecCtx(ctx, query, args...)
-	}, keys...)
-	return err
+		if err := session.QueryRowsCtx(ctx, &disabled, query, args...); err != nil {
+			return err
+		}
+		return recordChanges(ctx, session, ChangeDisabled, disabled...)
+	})
+	if err != nil {
+		return err
+	}
+	return m.DelCacheCtx(ctx, keys...)
 }
 
-// Insert 重写Insert方法，使用PostgreSQL的RETURNING子句获取插入后的ID
+// CountByFilter 按条件统计组织数量
+func (m *customOrganizationsModel) CountByFilter(ctx context.Context, filter *OrganizationsFilter) (int64, error) {
+	where, args := filter.where()
+	snapshot, table := m.snapshotSource(filter.AsOf, &args)
+	query := fmt.Sprintf("%sselect count(*) from %s where %s", withClause(snapshot), table, where)
+	var count int64
+	err := m.QueryRowNoCacheCtx(ctx, &count, query, args...)
+	return count, err
+}
+
+// FindPageByFilter 按条件分页查询组织
+func (m *customOrganizationsModel) FindPageByFilter(ctx context.Context, filter *OrganizationsFilter, limit, offset int64) ([]*Organizations, error) {
+	where, args := filter.where()
+	args = append(args, limit, offset)
+	limitArg, offsetArg := len(args)-1, len(args)
+	snapshot, table := m.snapshotSource(filter.AsOf, &args)
+	query := fmt.Sprintf("%sselect %s from %s where %s order by %s limit $%d offset $%d",
+		withClause(snapshot), organizationsRows, table, where, filter.orderBy(), limitArg, offsetArg)
+	var resp []*Organizations
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+// where 构建查询条件及参数
+func (f *OrganizationsFilter) where() (string, []any) {
+	var (
+		conds []string
+		args  []any
+	)
+	if f.ParentId == 0 {
+		conds = append(conds, "parent_id IS NULL")
+	} else {
+		args = append(args, f.ParentId)
+		conds = append(conds, fmt.Sprintf("parent_id = $%d", len(args)))
+	}
+	if !f.IncludeDeleted {
+		conds = append(conds, "deleted_at IS NULL")
+	}
+	if !f.IncludeDisabled {
+		conds = append(conds, "disabled_at IS NULL")
+	}
+	if f.NamePrefix != "" {
+		args = append(args, likeEscaper.Replace(f.NamePrefix)+"%")
+		conds = append(conds, fmt.Sprintf("name LIKE $%d", len(args)))
+	}
+	return strings.Join(conds, " and "), args
+}
+
+// orderBy 构建排序子句，追加 id 保证分页结果稳定；未指定或不支持的字段按创建时间排序
+func (f *OrganizationsFilter) orderBy() string {
+	field := f.SortField
+	if _, ok := organizationsSortFields[field]; !ok {
+		field = SortFieldCreatedAt
+	}
+	direction := "asc"
+	if f.Desc {
+		direction = "desc"
+	}
+	return fmt.Sprintf("%s %s, id %s", field, direction, direction)
+}
+
+// ValidateParent 验证 parentId 可以作为 id 的父节点：父节点需活跃，且不能是自身或其后代
+func (m *customOrganizationsModel) ValidateParent(ctx context.Context, id, parentId int64) error {
+	return m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		return m.validateParent(ctx, session, id, parentId)
+	})
+}
+
+// Move 移动组织到新的父节点下，newParentId 为 0 表示移动为根节点
+func (m *customOrganizationsModel) Move(ctx context.Context, id, newParentId int64, p Placement) error {
+	var (
+		movedIds, shiftedIds []int64
+		oldPath, newPath     string
+	)
+	err := m.transact(ctx, func(ctx context.Context, session sqlx.Session) error {
+		// 串行化树结构变更，避免两个并发移动互相形成环
+		if _, err := session.ExecCtx(ctx, "select pg_advisory_xact_lock($1)", treeLockKey); err != nil {
+			return err
+		}
+
+		var current Organizations
+		query := fmt.Sprintf("select %s from %s where id = $1 and deleted_at IS NULL limit 1 for update", organizationsRows, m.table)
+		if err := session.QueryRowCtx(ctx, &current, query, id); err != nil {
+			if errors.Is(err, sqlx.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		if err := m.validateParent(ctx, session, id, newParentId); err != nil {
+			return err
+		}
+
+		sortOrder, shifted, err := m.placeSibling(ctx, session, id, newParentId, p)
+		if err != nil {
+			return err
+		}
+		shiftedIds = shifted
+
+		parentId := sql.NullInt64{Valid: newParentId != 0, Int64: newParentId}
+		query = fmt.Sprintf("update %s set parent_id = $2, sort_order = $3 where id = $1", m.table)
+		if _, err := session.ExecCtx(ctx, query, id, parentId, sortOrder); err != nil {
+			return err
+		}
+		if err := m.moveClosure(ctx, session, id, newParentId); err != nil {
+			return err
+		}
+
+		// 整棵子树的物化路径都会改写，需清除其缓存
+		oldPath = current.Path
+		if movedIds, newPath, err = m.movePath(ctx, session, &current, newParentId); err != nil {
+			return err
+		}
+		if err := recordMoves(ctx, session, current.ParentId, id); err != nil {
+			return err
+		}
+		return recordChanges(ctx, session, ChangeReordered, shiftedIds...)
+	})
+	if err != nil {
+		return translateError(err)
+	}
+
+	keys := make([]string, 0, len(movedIds)+len(shiftedIds))
+	for _, movedId := range append(movedIds, shiftedIds...) {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, movedId))
+	}
+	// 子树从原祖先的数据范围移出，并入新祖先的数据范围
+	keys = append(keys, scopeCacheKeys(oldPath, newPath)...)
+	return m.DelCacheCtx(ctx, keys...)
+}
+
+// validateParent 在给定会话中验证父级关系
+func (m *customOrganizationsModel) validateParent(ctx context.Context, session sqlx.Session, id, parentId int64) error {
+	if parentId == 0 {
+		return nil
+	}
+	if parentId == id {
+		return ErrInvalidParent
+	}
+
+	var active bool
+	query := fmt.Sprintf("select exists(select 1 from %s where id = $1 and deleted_at IS NULL and disabled_at IS NULL)", m.table)
+	if err := session.QueryRowCtx(ctx, &active, query, parentId); err != nil {
+		return err
+	}
+	if !active {
+		return ErrParentNotActive
+	}
+
+	cyclic, err := m.isAncestor(ctx, session, id, parentId)
+	if err != nil {
+		return err
+	}
+	if cyclic {
+		return ErrCyclicParent
+	}
+	return nil
+}
+
+// ExistsByName 检查 parentId 下是否已存在规范化名称为 nameKey 的未删除组织，excludeId 用于更新时排除自身
+func (m *customOrganizationsModel) ExistsByName(ctx context.Context, parentId int64, nameKey string, excludeId int64) (bool, error) {
+	query := fmt.Sprintf("select exists(select 1 from %s where COALESCE(parent_id, 0) = $1 and name_key = $2 and id <> $3 and deleted_at IS NULL)", m.table)
+	var exists bool
+	err := m.QueryRowNoCacheCtx(ctx, &exists, query, parentId, nameKey, excludeId)
+	return exists, err
+}
+
+// RebuildNameKeys 按 normalize 重新生成所有组织（含已删除）的 name_key，返回变更的数量。
+// 用于迁移后以服务端规则替换数据库中近似回填的结果，或修改名称规范化配置之后；产生同级重名时返回 ErrDuplicateName
+func (m *customOrganizationsModel) RebuildNameKeys(ctx context.Context, normalize func(name string) string) (int64, error) {
+	var changed []struct {
+		Id   int64  `db:"id"`
+		Code string `db:"code"`
+	}
+	err := m.transact(ctx, func(ctx context.Context, session sqlx.Session) error {
+		var rows []struct {
+			Id      int64  `db:"id"`
+			Name    string `db:"name"`
+			NameKey string `db:"name_key"`
+		}
+		query := fmt.Sprintf("select id, name, name_key from %s for update", m.table)
+		if err := session.QueryRowsPartialCtx(ctx, &rows, query); err != nil {
+			return err
+		}
+		var ids []int64
+		var keys []string
+		for _, row := range rows {
+			if key := normalize(row.Name); key != row.NameKey {
+				ids = append(ids, row.Id)
+				keys = append(keys, key)
+			}
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		query = fmt.Sprintf(`update %s o set name_key = k.name_key
+from unnest($1::bigint[], $2::text[]) as k(id, name_key)
+where o.id = k.id
+returning o.id, o.code`, m.table)
+		return session.QueryRowsPartialCtx(ctx, &changed, query, pq.Array(ids), pq.Array(keys))
+	})
+	if err != nil {
+		return 0, translateError(err)
+	}
+	keys := make([]string, 0, 2*len(changed))
+	for _, org := range changed {
+		keys = append(keys,
+			fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, org.Id),
+			fmt.Sprintf("%s%v", cacheOrgOrganizationsCodePrefix, org.Code))
+	}
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	return int64(len(changed)), m.DelCacheCtx(ctx, keys...)
+}
+
+// translateError 将同级重名、编码重复的唯一约束冲突转换为 ErrDuplicateName、ErrDuplicateCode
+func translateError(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) || pqErr.Code != "23505" {
+		return err
+	}
+	switch pqErr.Constraint {
+	case siblingNameIndex:
+		return ErrDuplicateName
+	case codeIndex:
+		return ErrDuplicateCode
+	default:
+		return err
+	}
+}
+
+// Update 重写Update方法：锁定组织行后只修改名称、编码、类型与扩展属性，不回写父节点、路径、顺序及禁用、删除状态，
+// 避免覆盖并发的移动、排序、禁用与删除；在同一事务中写入变更事件并转换唯一约束错误，newData 更新为修改后的完整数据。
+// 组织不存在或已删除返回 ErrNotFound；编码变更时同时清除新编码的缓存（可能缓存了不存在的占位）
+func (m *customOrganizationsModel) Update(ctx context.Context, newData *Organizations) error {
+	var previousCode string
+	err := m.transact(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("select code from %s where id = $1 and deleted_at IS NULL limit 1 for update", m.table)
+		if err := session.QueryRowCtx(ctx, &previousCode, query, newData.Id); err != nil {
+			if errors.Is(err, sqlx.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		query = fmt.Sprintf("update %s set name = $2, name_key = $3, code = $4, org_type = $5, attributes = $6 where id = $1 returning %s", m.table, organizationsRows)
+		if err := session.QueryRowCtx(ctx, newData, query, newData.Id, newData.Name, newData.NameKey, newData.Code, newData.OrgType, newData.Attributes); err != nil {
+			return err
+		}
+		return recordChanges(ctx, session, ChangeUpdated, newData.Id)
+	})
+	if err != nil {
+		return translateError(err)
+	}
+	return m.DelCacheCtx(ctx,
+		fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, newData.Id),
+		fmt.Sprintf("%s%v", cacheOrgOrganizationsCodePrefix, previousCode),
+		fmt.Sprintf("%s%v", cacheOrgOrganizationsCodePrefix, newData.Code))
+}
+
+// Insert 重写Insert方法，新组织追加到兄弟节点末尾
 func (m *customOrganizationsModel) Insert(ctx context.Context, data *Organizations) (sql.Result, error) {
-	// 使用QueryRowCtx来处理RETURNING子句，获取插入后的ID
-	var insertedID int64
-	query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4) RETURNING id", m.table, organizationsRowsExpectAutoSet)
-	err := m.QueryRowNoCacheCtx(ctx, &insertedID, query, data.ParentId, data.Name, data.DisabledAt, data.DeletedAt)
+	return m.InsertAt(ctx, data, Placement{})
+}
+
+// InsertAt 在兄弟节点中的指定位置插入组织，使用PostgreSQL的RETURNING子句获取插入后的ID
+func (m *customOrganizationsModel) InsertAt(ctx context.Context, data *Organizations, p Placement) (sql.Result, error) {
+	// 使用QueryRowCtx来处理RETURNING子句，获取插入后的ID，并在同一事务中写入闭包关系
+	if data.Attributes == "" {
+		data.Attributes = emptyAttributes
+	}
+	var (
+		insertedID int64
+		shiftedIds []int64
+	)
+	err := m.transact(ctx, func(ctx context.Context, session sqlx.Session) error {
+		if _, err := session.ExecCtx(ctx, "select pg_advisory_xact_lock_shared($1)", treeLockKey); err != nil {
+			return err
+		}
+		var err error
+		if data.SortOrder, shiftedIds, err = m.placeSibling(ctx, session, 0, data.ParentId.Int64, p); err != nil {
+			return err
+		}
+		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id", m.table, organizationsRowsExpectAutoSet)
+		err = session.QueryRowCtx(ctx, &insertedID, query, data.ParentId, data.Name, data.DisabledAt, data.DeletedAt, data.NameKey, data.Path, data.OrgType, data.Code, data.Attributes, data.SortOrder)
+		if err != nil {
+			return err
+		}
+		if data.Path, err = m.initPath(ctx, session, insertedID, data.ParentId); err != nil {
+			return err
+		}
+		if err := m.insertClosure(ctx, session, insertedID, data.ParentId); err != nil {
+			return err
+		}
+		if err := recordChanges(ctx, session, ChangeCreated, insertedID); err != nil {
+			return err
+		}
+		return recordChanges(ctx, session, ChangeReordered, shiftedIds...)
+	})
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	// 设置插入后的ID到data对象中
 	data.Id = insertedID
 
 	// 清除相关缓存
-	orgOrganizationsIdKey := fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, insertedID)
+	keys := make([]string, 0, len(shiftedIds)+2)
+	keys = append(keys,
+		fmt.Sprintf("%s%v", cacheOrgOrganizationsCodePrefix, data.Code),
+		fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, insertedID))
+	// 被顺移的兄弟节点 sort_order 已变化
+	for _, shiftedId := range shiftedIds {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, shiftedId))
+	}
+	// 新组织计入各祖先的数据范围
+	keys = append(keys, scopeCacheKeys(data.Path)...)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		// 这里返回一个模拟的Result，包含正确的LastInsertId
 		return &customResult{insertedID: insertedID}, nil
-	}, orgOrganizationsIdKey)
+	}, keys...)
 
 	return &customResult{insertedID: insertedID}, err
 }
 
+// Delete 重写Delete方法：物理删除组织，子树与闭包关系由外键级联删除，并清除整棵子树的缓存
+func (m *customOrganizationsModel) Delete(ctx context.Context, id int64) error {
+	var rows []*codeRow
+	err := m.transact(ctx, func(ctx context.Context, session sqlx.Session) error {
+		if _, err := session.ExecCtx(ctx, "select pg_advisory_xact_lock($1)", treeLockKey); err != nil {
+			return err
+		}
+		query := fmt.Sprintf("select o.id, o.code, o.path from %s o join %s c on c.descendant_id = o.id where c.ancestor_id = $1", m.table, organizationClosureTable)
+		if err := session.QueryRowsCtx(ctx, &rows, query, id); err != nil {
+			return err
+		}
+		// 载荷为删除前的快照，事件不随组织一并删除
+		ids := make([]int64, 0, len(rows))
+		for _, row := range rows {
+			ids = append(ids, row.Id)
+		}
+		if err := recordChanges(ctx, session, ChangePurged, ids...); err != nil {
+			return err
+		}
+		query = fmt.Sprintf("delete from %s where id = $1", m.table)
+		_, err := session.ExecCtx(ctx, query, id)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, 3*len(rows))
+	paths := make([]string, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys,
+			fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, row.Id),
+			fmt.Sprintf("%s%v", cacheOrgOrganizationsCodePrefix, row.Code))
+		paths = append(paths, row.Path)
+	}
+	keys = append(keys, scopeCacheKeys(paths...)...)
+	return m.DelCacheCtx(ctx, keys...)
+}
+
 func (m *customOrganizationsModel) FindOne(ctx context.Context, id int64) (*Organizations, error) {
 	orgOrganizationsIdKey := fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, id)
 	var resp Organizations
@@ -24,13 +24,15 @@ var (
 	organizationsRowsExpectAutoSet   = strings.Join(stringx.Remove(organizationsFieldNames, "id", "create_at", "create_time", "created_at", "update_at", "update_time", "updated_at"), ",")
 	organizationsRowsWithPlaceHolder = builder.PostgreSqlJoin(stringx.Remove(organizationsFieldNames, "id", "create_at", "create_time", "created_at", "update_at", "update_time", "updated_at"))
 
-	cacheOrgOrganizationsIdPrefix = "cache:org:organizations:id:"
+	cacheOrgOrganizationsCodePrefix = "cache:org:organizations:code:"
+	cacheOrgOrganizationsIdPrefix   = "cache:org:organizations:id:"
 )
 
 type (
 	organizationsModel interface {
 		Insert(ctx context.Context, data *Organizations) (sql.Result, error)
 		FindOne(ctx context.Context, id int64) (*Organizations, error)
+		FindOneByCode(ctx context.Context, code string) (*Organizations, error)
 		Update(ctx context.Context, data *Organizations) error
 		Delete(ctx context.Context, id int64) error
 	}
@@ -48,6 +50,12 @@ type (
 		UpdatedAt  time.Time     `db:"updated_at"`
 		DisabledAt sql.NullTime  `db:"disabled_at"`
 		DeletedAt  sql.NullTime  `db:"deleted_at"`
+		NameKey    string        `db:"name_key"`
+		Path       string        `db:"path"`
+		OrgType    string        `db:"org_type"`
+		Code       string        `db:"code"`
+		Attributes string        `db:"attributes"`
+		SortOrder  int64         `db:"sort_order"`
 	}
 )
 
@@ -59,11 +67,17 @@ func newOrganizationsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.O
 }
 
 func (m *defaultOrganizationsModel) Delete(ctx context.Context, id int64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	orgOrganizationsCodeKey := fmt.Sprintf("%s%v", cacheOrgOrganizationsCodePrefix, data.Code)
 	orgOrganizationsIdKey := fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, id)
-	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("delete from %s where id = $1", m.table)
 		return conn.ExecCtx(ctx, query, id)
-	}, orgOrganizationsIdKey)
+	}, orgOrganizationsCodeKey, orgOrganizationsIdKey)
 	return err
 }
 
@@ -84,21 +98,48 @@ func (m *defaultOrganizationsModel) FindOne(ctx context.Context, id int64) (*Org
 	}
 }
 
+func (m *defaultOrganizationsModel) FindOneByCode(ctx context.Context, code string) (*Organizations, error) {
+	orgOrganizationsCodeKey := fmt.Sprintf("%s%v", cacheOrgOrganizationsCodePrefix, code)
+	var resp Organizations
+	err := m.QueryRowIndexCtx(ctx, &resp, orgOrganizationsCodeKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where code = $1 limit 1", organizationsRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, code); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
 func (m *defaultOrganizationsModel) Insert(ctx context.Context, data *Organizations) (sql.Result, error) {
+	orgOrganizationsCodeKey := fmt.Sprintf("%s%v", cacheOrgOrganizationsCodePrefix, data.Code)
 	orgOrganizationsIdKey := fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4)", m.table, organizationsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.ParentId, data.Name, data.DisabledAt, data.DeletedAt)
-	}, orgOrganizationsIdKey)
+		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)", m.table, organizationsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.ParentId, data.Name, data.DisabledAt, data.DeletedAt, data.NameKey, data.Path, data.OrgType, data.Code, data.Attributes, data.SortOrder)
+	}, orgOrganizationsCodeKey, orgOrganizationsIdKey)
 	return ret, err
 }
 
-func (m *defaultOrganizationsModel) Update(ctx context.Context, data *Organizations) error {
+func (m *defaultOrganizationsModel) Update(ctx context.Context, newData *Organizations) error {
+	data, err := m.FindOne(ctx, newData.Id)
+	if err != nil {
+		return err
+	}
+
+	orgOrganizationsCodeKey := fmt.Sprintf("%s%v", cacheOrgOrganizationsCodePrefix, data.Code)
 	orgOrganizationsIdKey := fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, data.Id)
-	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where id = $1", m.table, organizationsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, data.Id, data.ParentId, data.Name, data.DisabledAt, data.DeletedAt)
-	}, orgOrganizationsIdKey)
+		return conn.ExecCtx(ctx, query, newData.Id, newData.ParentId, newData.Name, newData.DisabledAt, newData.DeletedAt, newData.NameKey, newData.Path, newData.OrgType, newData.Code, newData.Attributes, newData.SortOrder)
+	}, orgOrganizationsCodeKey, orgOrganizationsIdKey)
 	return err
 }
 
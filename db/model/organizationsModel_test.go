@@ -0,0 +1,192 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 依赖数据库的基准测试需要已执行 db/init.sql 的 PostgreSQL，
+// 通过环境变量 ORG_TEST_DATASOURCE 指定，未设置时跳过
+const testDataSourceEnv = "ORG_TEST_DATASOURCE"
+
+// testCacheConf 测试使用本机的 Redis
+var testCacheConf = cache.CacheConf{{
+	RedisConf: redis.RedisConf{Host: "127.0.0.1:6379", Type: redis.NodeType},
+	Weight:    100,
+}}
+
+// newTestConn 连接 ORG_TEST_DATASOURCE 指定的数据库，未设置时跳过
+func newTestConn(tb testing.TB) sqlx.SqlConn {
+	tb.Helper()
+	dataSource := os.Getenv(testDataSourceEnv)
+	if dataSource == "" {
+		tb.Skipf("%s not set", testDataSourceEnv)
+	}
+	return sqlx.NewSqlConn("postgres", dataSource)
+}
+
+// insertTestOrg 在 parentId 下创建部门，编码为 prefix-name，返回组织 ID
+func insertTestOrg(t *testing.T, ctx context.Context, m OrganizationsModel, prefix, name string, parentId int64) int64 {
+	t.Helper()
+	org := &Organizations{
+		ParentId: sql.NullInt64{Valid: parentId != 0, Int64: parentId},
+		Name:     name,
+		NameKey:  name,
+		OrgType:  "department",
+		Code:     prefix + "-" + name,
+	}
+	if _, err := m.Insert(ctx, org); err != nil {
+		t.Fatal(err)
+	}
+	return org.Id
+}
+
+// cleanupTestOrgs 测试结束后删除组织，以及触发器为其写入的历史版本、审计日志与发件箱事件
+func cleanupTestOrgs(t *testing.T, conn sqlx.SqlConn, ids ...int64) {
+	t.Cleanup(func() {
+		ctx := context.Background()
+		_, _ = conn.ExecCtx(ctx, `delete from org.organizations where id = any($1)`, pq.Array(ids))
+		_, _ = conn.ExecCtx(ctx, `delete from org.organization_history where id = any($1)`, pq.Array(ids))
+		_, _ = conn.ExecCtx(ctx, `delete from org.audit_log where organization_id = any($1)`, pq.Array(ids))
+		_, _ = conn.ExecCtx(ctx, `delete from org.outbox where organization_id = any($1)`, pq.Array(ids))
+	})
+}
+
+func TestBuildOrganizationsTree(t *testing.T) {
+	org := func(id, parentId int64) *Organizations {
+		return &Organizations{Id: id, ParentId: sql.NullInt64{Valid: parentId != 0, Int64: parentId}}
+	}
+	// 按层级排序：1 -> (2 -> 4, 3)
+	tree := buildOrganizationsTree([]*Organizations{org(1, 0), org(2, 1), org(3, 1), org(4, 2)})
+
+	if tree.Id != 1 || len(tree.Children) != 2 {
+		t.Fatalf("unexpected root: id=%d children=%d", tree.Id, len(tree.Children))
+	}
+	if tree.Children[0].Id != 2 || tree.Children[1].Id != 3 {
+		t.Fatalf("unexpected children order: %d, %d", tree.Children[0].Id, tree.Children[1].Id)
+	}
+	if len(tree.Children[0].Children) != 1 || tree.Children[0].Children[0].Id != 4 {
+		t.Fatalf("grandchild not attached to node 2")
+	}
+	if tree.Children[1].Children == nil {
+		t.Fatalf("leaf children should be empty, not nil")
+	}
+}
+
+func TestTreeDepth(t *testing.T) {
+	org := func(id, parentId int64) *Organizations {
+		return &Organizations{Id: id, ParentId: sql.NullInt64{Valid: parentId != 0, Int64: parentId}}
+	}
+	tests := []struct {
+		name string
+		rows []*Organizations
+		want int
+	}{
+		{name: "root only", rows: []*Organizations{org(1, 0)}, want: 0},
+		{name: "subtree root with parent", rows: []*Organizations{org(5, 1), org(6, 5)}, want: 1},
+		{name: "deepest branch", rows: []*Organizations{org(1, 0), org(2, 1), org(3, 1), org(4, 2), org(7, 4)}, want: 3},
+	}
+	for _, tt := range tests {
+		if got := treeDepth(tt.rows); got != tt.want {
+			t.Errorf("%s: treeDepth = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkFindDescendants(b *testing.B) {
+	conn := newTestConn(b)
+	// 后代查询不经过缓存，Redis 配置仅用于构造 model
+	m := NewOrganizationsModel(conn, testCacheConf).(*customOrganizationsModel)
+	ctx := context.Background()
+
+	shapes := []struct{ fanout, depth int }{
+		{fanout: 5, depth: 3},  // 156 个节点
+		{fanout: 10, depth: 3}, // 1111 个节点
+		{fanout: 8, depth: 4},  // 4681 个节点
+	}
+	for _, shape := range shapes {
+		rootId, nodes := generateTree(b, conn, shape.fanout, shape.depth)
+
+		b.Run(fmt.Sprintf("nodes=%d/n+1", nodes), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := findDescendantsByParentQueries(ctx, m, rootId); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("nodes=%d/recursive_cte", nodes), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := m.FindDescendantsById(ctx, rootId); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// generateTree 生成每个节点有 fanout 个子节点、共 depth 层的组织树，返回根节点 ID 与节点总数
+func generateTree(b *testing.B, conn sqlx.SqlConn, fanout, depth int) (int64, int) {
+	b.Helper()
+	ctx := context.Background()
+
+	name := fmt.Sprintf("bench-%d-%d-%d", fanout, depth, time.Now().UnixNano())
+	var rootId int64
+	err := conn.QueryRowCtx(ctx, &rootId,
+		`insert into org.organizations (parent_id, name, name_key, code) values (NULL, $1, $1, $1) returning id`, name)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() {
+		// parent_id 外键为 ON DELETE CASCADE，删除根节点即可清理整棵树
+		_, _ = conn.ExecCtx(ctx, `delete from org.organizations where id = $1`, rootId)
+	})
+
+	nodes, level := 1, []int64{rootId}
+	for d := 0; d < depth; d++ {
+		var next []int64
+		err = conn.QueryRowsCtx(ctx, &next, `insert into org.organizations (parent_id, name, name_key, code)
+select p.id, 'node-' || g, 'node-' || g, $3 || '-' || p.id || '-' || g from unnest($1::bigint[]) p(id), generate_series(1, $2) g
+returning id`, pq.Array(level), fanout, name)
+		if err != nil {
+			b.Fatal(err)
+		}
+		nodes += len(next)
+		level = next
+	}
+	return rootId, nodes
+}
+
+// findDescendantsByParentQueries 重构前的实现：每个节点单独查询一次子节点
+func findDescendantsByParentQueries(ctx context.Context, m *customOrganizationsModel, id int64) (*OrganizationsTree, error) {
+	org, err := m.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	tree := &OrganizationsTree{Organizations: org, Children: []*OrganizationsTree{}}
+
+	var build func(*OrganizationsTree) error
+	build = func(node *OrganizationsTree) error {
+		children, err := m.FindByParentId(ctx, node.Id)
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			childNode := &OrganizationsTree{Organizations: child, Children: []*OrganizationsTree{}}
+			node.Children = append(node.Children, childNode)
+			if err := build(childNode); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return tree, build(tree)
+}
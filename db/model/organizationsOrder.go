@@ -0,0 +1,107 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// Placement 组织在兄弟节点中的位置，BeforeId 与 AfterId 至多设置一个；均为 0 时追加到末尾
+type Placement struct {
+	BeforeId int64 // 放在该兄弟节点之前
+	AfterId  int64 // 放在该兄弟节点之后
+}
+
+// placeSibling 在 parentId 的子组织中为组织 id 腾出 p 指定的位置，返回应写入的 sort_order 及被后移的兄弟节点
+// 新插入的组织 id 传 0；追加到末尾时不改动其他兄弟节点
+// 插入只持有共享锁，并发追加可能得到相同的 sort_order，此时按 created_at、id 排序
+func (m *customOrganizationsModel) placeSibling(ctx context.Context, session sqlx.Session, id, parentId int64, p Placement) (int64, []int64, error) {
+	if p.BeforeId == 0 && p.AfterId == 0 {
+		var next int64
+		query := fmt.Sprintf("select COALESCE(MAX(sort_order) + 1, 0) from %s where COALESCE(parent_id, 0) = $1 and id <> $2 and deleted_at IS NULL", m.table)
+		err := session.QueryRowCtx(ctx, &next, query, parentId, id)
+		return next, nil, err
+	}
+
+	anchorId, offset := p.BeforeId, int64(0)
+	if anchorId == 0 {
+		anchorId, offset = p.AfterId, 1
+	}
+	if anchorId == id {
+		return 0, nil, ErrInvalidPlacement
+	}
+	var anchor int64
+	query := fmt.Sprintf("select sort_order from %s where id = $1 and COALESCE(parent_id, 0) = $2 and deleted_at IS NULL limit 1", m.table)
+	if err := session.QueryRowCtx(ctx, &anchor, query, anchorId, parentId); err != nil {
+		if errors.Is(err, sqlx.ErrNotFound) {
+			return 0, nil, ErrInvalidPlacement
+		}
+		return 0, nil, err
+	}
+
+	position := anchor + offset
+	var shifted []int64
+	query = fmt.Sprintf(`update %s set sort_order = sort_order + 1
+where COALESCE(parent_id, 0) = $1 and sort_order >= $2 and id <> $3 and deleted_at IS NULL returning id`, m.table)
+	if err := session.QueryRowsCtx(ctx, &shifted, query, parentId, position, id); err != nil {
+		return 0, nil, err
+	}
+	return position, shifted, nil
+}
+
+// ReorderChildren 在一个事务中按 orderedIds 的顺序重写 parentId 下全部未删除子组织的 sort_order
+// orderedIds 必须恰好包含全部子组织各一次，否则返回 ErrInvalidOrder
+func (m *customOrganizationsModel) ReorderChildren(ctx context.Context, parentId int64, orderedIds []int64) error {
+	err := m.transact(ctx, func(ctx context.Context, session sqlx.Session) error {
+		// 共享锁阻止并发移动改变子组织集合
+		if _, err := session.ExecCtx(ctx, "select pg_advisory_xact_lock_shared($1)", treeLockKey); err != nil {
+			return err
+		}
+
+		var children []int64
+		query := fmt.Sprintf("select id from %s where COALESCE(parent_id, 0) = $1 and deleted_at IS NULL for update", m.table)
+		if err := session.QueryRowsCtx(ctx, &children, query, parentId); err != nil {
+			return err
+		}
+		if !samePermutation(children, orderedIds) {
+			return ErrInvalidOrder
+		}
+
+		query = fmt.Sprintf(`update %s t set sort_order = v.ord - 1
+from unnest($1::bigint[]) with ordinality as v(id, ord) where t.id = v.id`, m.table)
+		if _, err := session.ExecCtx(ctx, query, pq.Array(orderedIds)); err != nil {
+			return err
+		}
+		return recordChanges(ctx, session, ChangeReordered, orderedIds...)
+	})
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(orderedIds))
+	for _, id := range orderedIds {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, id))
+	}
+	return m.DelCacheCtx(ctx, keys...)
+}
+
+// samePermutation 判断 ordered 是否恰好包含 ids 中的每个元素各一次
+func samePermutation(ids, ordered []int64) bool {
+	if len(ids) != len(ordered) {
+		return false
+	}
+	pending := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		pending[id] = struct{}{}
+	}
+	for _, id := range ordered {
+		if _, ok := pending[id]; !ok {
+			return false
+		}
+		delete(pending, id)
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// pathDepthExpr 物化路径的分隔符数量，与索引 idx_org_path_depth 的表达式一致；根节点为 2
+const pathDepthExpr = "(LENGTH(path) - LENGTH(REPLACE(path, '/', '')))"
+
+// FindSubtreeByPath 按物化路径前缀查询未删除的子树组织（含自身），按路径排序
+func (m *customOrganizationsModel) FindSubtreeByPath(ctx context.Context, path string) ([]*Organizations, error) {
+	query := fmt.Sprintf("select %s from %s where path LIKE $1 and deleted_at IS NULL order by path", organizationsRows, m.table)
+	var resp []*Organizations
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, likeEscaper.Replace(path)+"%")
+	return resp, err
+}
+
+// FindByPathDepth 查询路径前缀下处于指定层级的未删除组织，depth 为绝对层级，根节点为 0
+func (m *customOrganizationsModel) FindByPathDepth(ctx context.Context, path string, depth int) ([]*Organizations, error) {
+	query := fmt.Sprintf("select %s from %s where path LIKE $1 and %s = $2 and deleted_at IS NULL order by path",
+		organizationsRows, m.table, pathDepthExpr)
+	var resp []*Organizations
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, likeEscaper.Replace(path)+"%", depth+2)
+	return resp, err
+}
+
+// initPath 根据父节点路径写入新插入组织的物化路径
+func (m *customOrganizationsModel) initPath(ctx context.Context, session sqlx.Session, id int64, parentId sql.NullInt64) (string, error) {
+	query := fmt.Sprintf(`update %[1]s set path = COALESCE((select p.path from %[1]s p where p.id = $2), '/') || id || '/'
+where id = $1 returning path`, m.table)
+	var path string
+	err := session.QueryRowCtx(ctx, &path, query, id, parentId)
+	return path, err
+}
+
+// movePath 将 org 子树的物化路径前缀替换为新父节点下的路径，返回受影响的组织 ID 及 org 的新路径
+func (m *customOrganizationsModel) movePath(ctx context.Context, session sqlx.Session, org *Organizations, newParentId int64) ([]int64, string, error) {
+	if org.Path == "" {
+		return nil, "", fmt.Errorf("organization %d has no materialized path", org.Id)
+	}
+
+	newPath := "/"
+	if newParentId != 0 {
+		query := fmt.Sprintf("select path from %s where id = $1", m.table)
+		if err := session.QueryRowCtx(ctx, &newPath, query, newParentId); err != nil {
+			return nil, "", err
+		}
+	}
+	newPath += strconv.FormatInt(org.Id, 10) + "/"
+
+	query := fmt.Sprintf("update %s set path = $2 || SUBSTR(path, LENGTH($1) + 1) where path LIKE $1 || '%%' returning id", m.table)
+	var ids []int64
+	err := session.QueryRowsCtx(ctx, &ids, query, org.Path, newPath)
+	return ids, newPath, err
+}
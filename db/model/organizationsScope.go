@@ -0,0 +1,79 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// cacheOrgScopeIdPrefix 组织数据范围（自身及全部未删除后代的 ID）缓存前缀
+var cacheOrgScopeIdPrefix = "cache:org:scope:id:"
+
+// pathRow 组织 ID 与物化路径，用于清除祖先的数据范围缓存
+type pathRow struct {
+	Id   int64  `db:"id"`
+	Path string `db:"path"`
+}
+
+// FindSubtreeIds 查询组织自身及全部未删除后代的 ID（含已禁用组织），按 ID 升序，结果缓存
+// 子树内插入、移入移出、删除或恢复组织时，由对应的写方法按物化路径清除各祖先的缓存
+func (m *customOrganizationsModel) FindSubtreeIds(ctx context.Context, id int64) ([]int64, error) {
+	orgScopeIdKey := fmt.Sprintf("%s%v", cacheOrgScopeIdPrefix, id)
+	var ids []int64
+	err := m.QueryRowCtx(ctx, &ids, orgScopeIdKey, func(ctx context.Context, conn sqlx.SqlConn, v any) error {
+		// 组织自身已删除时，其后代也不计入
+		query := fmt.Sprintf(`select c.descendant_id from %[1]s c join %[2]s o on o.id = c.descendant_id
+where c.ancestor_id = $1 and o.deleted_at IS NULL
+and exists(select 1 from %[2]s s where s.id = $1 and s.deleted_at IS NULL)
+order by c.descendant_id`, organizationClosureTable, m.table)
+		if err := conn.QueryRowsCtx(ctx, v, query, id); err != nil {
+			return err
+		}
+		if len(*v.(*[]int64)) == 0 {
+			return sqlc.ErrNotFound
+		}
+		return nil
+	})
+	switch {
+	case err == nil:
+		return ids, nil
+	case errors.Is(err, sqlc.ErrNotFound):
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// scopeCacheKeys 返回物化路径上各组织（即全部祖先及自身）的数据范围缓存键，去重
+func scopeCacheKeys(paths ...string) []string {
+	seen := make(map[string]struct{})
+	keys := make([]string, 0, len(paths))
+	for _, path := range paths {
+		for _, part := range strings.Split(strings.Trim(path, "/"), "/") {
+			if _, ok := seen[part]; ok || part == "" {
+				continue
+			}
+			seen[part] = struct{}{}
+			keys = append(keys, cacheOrgScopeIdPrefix+part)
+		}
+	}
+	return keys
+}
+
+// FindExistingIds 过滤出给定 ID 中未删除的组织（含已禁用组织），去重并按 ID 升序，不存在的 ID 直接忽略
+func (m *customOrganizationsModel) FindExistingIds(ctx context.Context, ids []int64) ([]int64, error) {
+	resp := make([]int64, 0, len(ids))
+	if len(ids) == 0 {
+		return resp, nil
+	}
+	query := fmt.Sprintf("select id from %s where id = any($1) and deleted_at IS NULL order by id", m.table)
+	if err := m.QueryRowsNoCacheCtx(ctx, &resp, query, pq.Array(ids)); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
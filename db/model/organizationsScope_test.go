@@ -0,0 +1,30 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestFindExistingIds 过滤自定义数据范围的组织 ID：忽略已删除及不存在的组织，去重并升序
+func TestFindExistingIds(t *testing.T) {
+	conn := newTestConn(t)
+	m := NewOrganizationsModel(conn, testCacheConf)
+	ctx := context.Background()
+
+	prefix := fmt.Sprintf("scope-%d", time.Now().UnixNano())
+	rootId := insertTestOrg(t, ctx, m, prefix, prefix, 0)
+	aId := insertTestOrg(t, ctx, m, prefix, "a", rootId)
+	bId := insertTestOrg(t, ctx, m, prefix, "b", rootId)
+	cleanupTestOrgs(t, conn, rootId, aId, bId)
+	if err := m.SoftDelete(ctx, bId); err != nil {
+		t.Fatal(err)
+	}
+
+	ids, err := m.FindExistingIds(ctx, []int64{aId, bId, -1, rootId, aId})
+	if err != nil || !slices.Equal(ids, []int64{rootId, aId}) {
+		t.Fatalf("FindExistingIds = %v, %v; want [%d %d]", ids, err, rootId, aId)
+	}
+}
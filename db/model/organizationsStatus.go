@@ -0,0 +1,257 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// DeleteMode 删除组织时对子组织的处理方式
+type DeleteMode int
+
+const (
+	DeleteRejectIfChildren DeleteMode = iota // 存在未删除的子组织时拒绝
+	DeleteCascade                            // 级联软删除整棵子树
+	DeleteRehomeChildren                     // 子组织挂到被删除组织的父节点下
+)
+
+// statusChange 组织状态变更的描述
+type statusChange struct {
+	lockCond    string // 锁定目标组织时的附加条件
+	checkParent bool   // 是否要求父节点处于活跃状态
+	set         string // 更新的字段
+	cond        string // 仅更新满足条件的行
+	scoped      bool   // 是否改变数据范围；数据范围不含已删除组织，但包含已禁用组织
+	event       string // 写入发件箱的变更类型
+}
+
+var (
+	disableChange = statusChange{
+		lockCond: "deleted_at IS NULL",
+		set:      "disabled_at = NOW()",
+		cond:     "deleted_at IS NULL and disabled_at IS NULL",
+		event:    ChangeDisabled,
+	}
+	enableChange = statusChange{
+		lockCond:    "deleted_at IS NULL",
+		checkParent: true,
+		set:         "disabled_at = NULL",
+		cond:        "deleted_at IS NULL and disabled_at IS NOT NULL",
+		event:       ChangeEnabled,
+	}
+	restoreChange = statusChange{
+		lockCond:    "deleted_at IS NOT NULL",
+		checkParent: true,
+		set:         "deleted_at = NULL",
+		cond:        "deleted_at IS NOT NULL",
+		scoped:      true,
+		event:       ChangeRestored,
+	}
+)
+
+// DisableNode 禁用组织，cascade 为 true 时同时禁用整棵子树，返回受影响的组织数量
+func (m *customOrganizationsModel) DisableNode(ctx context.Context, id int64, cascade bool) (int64, error) {
+	return m.changeStatus(ctx, id, cascade, disableChange)
+}
+
+// EnableNode 启用组织，父节点已禁用或已删除时返回 ErrParentNotActive
+func (m *customOrganizationsModel) EnableNode(ctx context.Context, id int64, cascade bool) (int64, error) {
+	return m.changeStatus(ctx, id, cascade, enableChange)
+}
+
+// RestoreNode 恢复已软删除的组织，父节点已禁用或已删除时返回 ErrParentNotActive
+func (m *customOrganizationsModel) RestoreNode(ctx context.Context, id int64, cascade bool) (int64, error) {
+	return m.changeStatus(ctx, id, cascade, restoreChange)
+}
+
+// changeStatus 在一个事务中锁定目标组织、校验父节点并更新状态，更新后清除受影响组织的缓存
+func (m *customOrganizationsModel) changeStatus(ctx context.Context, id int64, cascade bool, change statusChange) (int64, error) {
+	var rows []*pathRow
+	err := m.transact(ctx, func(ctx context.Context, session sqlx.Session) error {
+		if _, err := session.ExecCtx(ctx, "select pg_advisory_xact_lock_shared($1)", treeLockKey); err != nil {
+			return err
+		}
+
+		var current Organizations
+		query := fmt.Sprintf("select %s from %s where id = $1 and %s limit 1 for update", organizationsRows, m.table, change.lockCond)
+		if err := session.QueryRowCtx(ctx, &current, query, id); err != nil {
+			if errors.Is(err, sqlx.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		if change.checkParent && current.ParentId.Valid {
+			var active bool
+			query = fmt.Sprintf("select exists(select 1 from %s where id = $1 and deleted_at IS NULL and disabled_at IS NULL)", m.table)
+			if err := session.QueryRowCtx(ctx, &active, query, current.ParentId.Int64); err != nil {
+				return err
+			}
+			if !active {
+				return ErrParentNotActive
+			}
+		}
+
+		target := "id = $1"
+		if cascade {
+			target = fmt.Sprintf("id in (select descendant_id from %s where ancestor_id = $1)", organizationClosureTable)
+		}
+		query = fmt.Sprintf("update %s set %s where %s and %s returning id, path", m.table, change.set, target, change.cond)
+		if err := session.QueryRowsCtx(ctx, &rows, query, id); err != nil {
+			return err
+		}
+		changedIds := make([]int64, 0, len(rows))
+		for _, row := range rows {
+			changedIds = append(changedIds, row.Id)
+		}
+		return recordChanges(ctx, session, change.event, changedIds...)
+	})
+	if err != nil {
+		return 0, translateError(err)
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	keys := make([]string, 0, len(rows))
+	paths := make([]string, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, row.Id))
+		paths = append(paths, row.Path)
+	}
+	if change.scoped {
+		keys = append(keys, scopeCacheKeys(paths...)...)
+	}
+	return int64(len(rows)), m.DelCacheCtx(ctx, keys...)
+}
+
+// DeleteNode 按 mode 在一个事务中软删除组织，返回被删除的组织数量
+// 软删除同时清除 disabled_at，以满足 chk_deleted_not_disabled 约束
+func (m *customOrganizationsModel) DeleteNode(ctx context.Context, id int64, mode DeleteMode) (int64, error) {
+	var (
+		deleted       []*pathRow
+		ids           []int64
+		membershipIds []int64
+	)
+	err := m.transact(ctx, func(ctx context.Context, session sqlx.Session) error {
+		// 转移子组织会改写闭包关系，需要排他锁
+		lock := "select pg_advisory_xact_lock_shared($1)"
+		if mode == DeleteRehomeChildren {
+			lock = "select pg_advisory_xact_lock($1)"
+		}
+		if _, err := session.ExecCtx(ctx, lock, treeLockKey); err != nil {
+			return err
+		}
+
+		var current Organizations
+		query := fmt.Sprintf("select %s from %s where id = $1 and deleted_at IS NULL limit 1 for update", organizationsRows, m.table)
+		if err := session.QueryRowCtx(ctx, &current, query, id); err != nil {
+			if errors.Is(err, sqlx.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		target := "id = $1"
+		softDelete := func() error {
+			query := fmt.Sprintf("update %s set deleted_at = NOW(), disabled_at = NULL where %s and deleted_at IS NULL returning id, path", m.table, target)
+			if err := session.QueryRowsCtx(ctx, &deleted, query, id); err != nil {
+				return err
+			}
+			deletedIds := make([]int64, 0, len(deleted))
+			for _, row := range deleted {
+				deletedIds = append(deletedIds, row.Id)
+			}
+			ids = append(ids, deletedIds...)
+			if err := recordChanges(ctx, session, ChangeDeleted, deletedIds...); err != nil {
+				return err
+			}
+
+			// 被删除组织的成员关系随之结束，恢复组织不会恢复成员关系
+			query = fmt.Sprintf("update %s set left_at = NOW() where organization_id = any($1) and left_at IS NULL returning id", membershipsTable)
+			return session.QueryRowsCtx(ctx, &membershipIds, query, pq.Array(deletedIds))
+		}
+
+		switch mode {
+		case DeleteRejectIfChildren:
+			var hasChildren bool
+			query = fmt.Sprintf("select exists(select 1 from %s where parent_id = $1 and deleted_at IS NULL)", m.table)
+			if err := session.QueryRowCtx(ctx, &hasChildren, query, id); err != nil {
+				return err
+			}
+			if hasChildren {
+				return ErrHasChildren
+			}
+		case DeleteCascade:
+			target = fmt.Sprintf("id in (select descendant_id from %s where ancestor_id = $1)", organizationClosureTable)
+		case DeleteRehomeChildren:
+			// 先删除组织自身，使其不再参与兄弟名称唯一约束，与其同名的子组织才能转移到原父节点下
+			if err := softDelete(); err != nil {
+				return err
+			}
+			var children []*Organizations
+			query = fmt.Sprintf("select %s from %s where parent_id = $1 and deleted_at IS NULL for update", organizationsRows, m.table)
+			if err := session.QueryRowsCtx(ctx, &children, query, id); err != nil {
+				return err
+			}
+			// 子组织按原顺序占据被删除组织的位置，其后的兄弟节点依次后移
+			if len(children) > 1 {
+				query = fmt.Sprintf(`update %s set sort_order = sort_order + $3
+where COALESCE(parent_id, 0) = $1 and sort_order > $2 and deleted_at IS NULL returning id`, m.table)
+				var shiftedIds []int64
+				if err := session.QueryRowsCtx(ctx, &shiftedIds, query, current.ParentId.Int64, current.SortOrder, len(children)-1); err != nil {
+					return err
+				}
+				ids = append(ids, shiftedIds...)
+				if err := recordChanges(ctx, session, ChangeReordered, shiftedIds...); err != nil {
+					return err
+				}
+			}
+			query = fmt.Sprintf(`update %[1]s t set parent_id = $2, sort_order = $3 + c.rn
+from (select id, row_number() over (order by sort_order, created_at, id) - 1 as rn from %[1]s where parent_id = $1 and deleted_at IS NULL) c
+where t.id = c.id`, m.table)
+			if _, err := session.ExecCtx(ctx, query, id, current.ParentId, current.SortOrder); err != nil {
+				return err
+			}
+			for _, child := range children {
+				if err := m.moveClosure(ctx, session, child.Id, current.ParentId.Int64); err != nil {
+					return err
+				}
+				movedIds, _, err := m.movePath(ctx, session, child, current.ParentId.Int64)
+				if err != nil {
+					return err
+				}
+				ids = append(ids, movedIds...)
+				if err := recordMoves(ctx, session, sql.NullInt64{Valid: true, Int64: id}, child.Id); err != nil {
+					return err
+				}
+			}
+			return nil
+		default:
+			return fmt.Errorf("unknown delete mode %d", mode)
+		}
+		return softDelete()
+	})
+	if err != nil {
+		return 0, translateError(err)
+	}
+
+	keys := make([]string, 0, len(ids)+len(membershipIds))
+	for _, changedId := range ids {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheOrgOrganizationsIdPrefix, changedId))
+	}
+	for _, membershipId := range membershipIds {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheOrgMembershipsIdPrefix, membershipId))
+	}
+	// 被删除组织移出各祖先的数据范围；转移的子组织仍在原祖先链下，已随被删除组织的路径一并清除
+	paths := make([]string, 0, len(deleted))
+	for _, row := range deleted {
+		paths = append(paths, row.Path)
+	}
+	keys = append(keys, scopeCacheKeys(paths...)...)
+	return int64(len(deleted)), m.DelCacheCtx(ctx, keys...)
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// WatchSnapshot 订阅组织树时的子树快照及其对应的发件箱版本
+type WatchSnapshot struct {
+	Revision      int64            // 快照之后从该版本之后推送变更；之后的部分事件可能已包含在快照中
+	Covered       int64            // 快照已包含该版本及之前的全部移动
+	Organizations []*Organizations // 子树中未删除的组织（含已禁用），按物化路径排序，父节点先于子节点
+}
+
+// FindWatchSnapshot 查询 rootId 子树的快照，rootId 为 0 表示整个组织树；根节点不存在或已删除时返回 ErrNotFound
+// 事务开始早于 settle 之前、且仍未提交的变更可能既不在快照中，也不在 Revision 之后
+func (m *customOrganizationsModel) FindWatchSnapshot(ctx context.Context, rootId int64, settle time.Duration) (*WatchSnapshot, error) {
+	var snapshot WatchSnapshot
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		// 共享锁等待进行中的移动提交，快照包含 Covered 及之前的全部移动
+		if _, err := session.ExecCtx(ctx, "select pg_advisory_xact_lock_shared($1)", treeLockKey); err != nil {
+			return err
+		}
+
+		// 较新的事件之前可能还有未提交的事件，从最后一个早于 settle 的事件之后推送
+		query := fmt.Sprintf("select COALESCE((select id from %s where created_at <= $1 order by id desc limit 1), 0)", outboxTable)
+		if err := session.QueryRowCtx(ctx, &snapshot.Revision, query, time.Now().Add(-settle)); err != nil {
+			return err
+		}
+		query = fmt.Sprintf("select COALESCE(MAX(id), 0) from %s", outboxTable)
+		if err := session.QueryRowCtx(ctx, &snapshot.Covered, query); err != nil {
+			return err
+		}
+		snapshot.Covered = max(snapshot.Covered, snapshot.Revision)
+
+		if rootId == 0 {
+			query = fmt.Sprintf("select %s from %s where deleted_at IS NULL order by path", organizationsRows, m.table)
+			return session.QueryRowsCtx(ctx, &snapshot.Organizations, query)
+		}
+		query = fmt.Sprintf(`select %s from %s o join %s c on c.descendant_id = o.id
+where c.ancestor_id = $1 and o.deleted_at IS NULL order by o.path`, organizationsRowsWithAlias, m.table, organizationClosureTable)
+		if err := session.QueryRowsCtx(ctx, &snapshot.Organizations, query, rootId); err != nil {
+			return err
+		}
+		if len(snapshot.Organizations) == 0 || snapshot.Organizations[0].Id != rootId {
+			return ErrNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, sqlx.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &snapshot, nil
+}
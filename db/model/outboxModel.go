@@ -0,0 +1,226 @@
+package model
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ OutboxModel = (*customOutboxModel)(nil)
+
+type (
+	// OutboxModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customOutboxModel.
+	OutboxModel interface {
+		outboxModel
+		withSession(session sqlx.Session) OutboxModel
+		ClaimPending(ctx context.Context, limit int, lease time.Duration, fanout FanoutFunc) ([]*Outbox, error) // 领取一批到期的待投递事件
+		MarkPublished(ctx context.Context, id int64) error                                                      // 标记事件已投递
+		MarkFailed(ctx context.Context, id int64, retryAt time.Time, dead bool, reason string) error            // 记录一次投递失败
+		PurgePublished(ctx context.Context, before time.Time) (int64, error)                                    // 清理早于 before 投递成功或转为死信的事件
+		FindSince(ctx context.Context, after int64, limit int) ([]*Outbox, error)                               // 按 ID 顺序查询 after 之后的事件
+		FindRevisionRange(ctx context.Context) (oldest, latest int64, err error)                                // 查询保留的最早与最新事件 ID
+	}
+
+	customOutboxModel struct {
+		*defaultOutboxModel
+	}
+)
+
+// FanoutFunc 为一批发件箱事件生成下游的 Webhook 投递记录
+type FanoutFunc func(ctx context.Context, events []*Outbox) ([]*WebhookDeliveries, error)
+
+// 组织变更类型，与表 org.outbox 的 event_type 取值一致
+const (
+	ChangeCreated   = "created"   // 创建
+	ChangeUpdated   = "updated"   // 修改名称、编码或扩展属性
+	ChangeMoved     = "moved"     // 移动到新的父节点下，含删除时被转移的子组织
+	ChangeDisabled  = "disabled"  // 禁用
+	ChangeEnabled   = "enabled"   // 启用
+	ChangeDeleted   = "deleted"   // 软删除
+	ChangeRestored  = "restored"  // 恢复
+	ChangeReordered = "reordered" // 兄弟顺序变化
+	ChangePurged    = "purged"    // 物理删除
+)
+
+// outboxTable 组织变更事件发件箱，与组织变更在同一事务中写入
+const outboxTable = `"org"."outbox"`
+
+// NewOutboxModel returns a model for the database table.
+func NewOutboxModel(conn sqlx.SqlConn) OutboxModel {
+	return &customOutboxModel{
+		defaultOutboxModel: newOutboxModel(conn),
+	}
+}
+
+func (m *customOutboxModel) withSession(session sqlx.Session) OutboxModel {
+	return NewOutboxModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// ClaimPending 领取至多 limit 条到期的待投递事件，并将其下次投递时间推迟 lease，返回按 ID 排序的事件。
+// 每个组织只领取最早的一条待投递事件，已领取但未出结果的事件同样阻塞同一组织的后续事件，以保证单个组织的事件按顺序投递；
+// 领取使用 skip locked，多个实例可同时投递。领取方在 lease 内未标记结果（如进程退出）时，事件到期后会被重新领取（至少一次）。
+// fanout 不为空时，在领取的同一事务中为首次领取的事件创建 fanout 返回的 Webhook 投递记录，创建失败时本次领取一并回滚
+func (m *customOutboxModel) ClaimPending(ctx context.Context, limit int, lease time.Duration, fanout FanoutFunc) ([]*Outbox, error) {
+	query := fmt.Sprintf(`update %[1]s set next_attempt_at = $2 where id in (
+select id from %[1]s o where published_at IS NULL and dead_at IS NULL and next_attempt_at <= NOW()
+and not exists(select 1 from %[1]s p where p.organization_id = o.organization_id and p.published_at IS NULL and p.dead_at IS NULL and p.id < o.id)
+order by id limit $1 for update skip locked)
+returning %[2]s`, m.table, outboxRows)
+	var resp []*Outbox
+	err := m.conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		if err := session.QueryRowsCtx(ctx, &resp, query, limit, time.Now().Add(lease)); err != nil {
+			return err
+		}
+		slices.SortFunc(resp, func(a, b *Outbox) int {
+			return cmp.Compare(a.Id, b.Id)
+		})
+		if fanout == nil {
+			return nil
+		}
+
+		// 失败过的事件在首次领取时已创建投递记录；租期到期被重新领取的事件重复创建时忽略已存在的记录
+		var fresh []*Outbox
+		for _, event := range resp {
+			if event.Attempts == 0 {
+				fresh = append(fresh, event)
+			}
+		}
+		if len(fresh) == 0 {
+			return nil
+		}
+		deliveries, err := fanout(ctx, fresh)
+		if err != nil {
+			return err
+		}
+		return NewWebhookDeliveriesModel(sqlx.NewSqlConnFromSession(session)).Enqueue(ctx, deliveries)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// MarkPublished 将事件标记为已投递
+func (m *customOutboxModel) MarkPublished(ctx context.Context, id int64) error {
+	query := fmt.Sprintf("update %s set published_at = NOW(), last_error = NULL where id = $1 and published_at IS NULL and dead_at IS NULL", m.table)
+	_, err := m.conn.ExecCtx(ctx, query, id)
+	return err
+}
+
+// MarkFailed 累加事件的失败次数并记录原因：dead 为 true 时转为死信，否则推迟到 retryAt 重试
+func (m *customOutboxModel) MarkFailed(ctx context.Context, id int64, retryAt time.Time, dead bool, reason string) error {
+	query := fmt.Sprintf(`update %s set attempts = attempts + 1, next_attempt_at = $2, last_error = $3,
+dead_at = CASE WHEN $4 THEN NOW() END where id = $1 and published_at IS NULL and dead_at IS NULL`, m.table)
+	_, err := m.conn.ExecCtx(ctx, query, id, retryAt, reason, dead)
+	return err
+}
+
+// PurgePublished 删除早于 before 投递成功或转为死信的事件，返回删除的数量
+// 只删除最早的待投递事件之前的部分，保证保留的事件 ID 连续，订阅方可据最早的 ID 判断能否从某个版本继续
+func (m *customOutboxModel) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	query := fmt.Sprintf(`delete from %[1]s where COALESCE(published_at, dead_at) < $1
+and id < COALESCE((select MIN(id) from %[1]s where published_at IS NULL and dead_at IS NULL), 9223372036854775807)`, m.table)
+	result, err := m.conn.ExecCtx(ctx, query, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// FindSince 按 ID 顺序查询 after 之后的至多 limit 条事件
+// 事件 ID 在事务提交前分配，较小的 ID 可能晚于较大的 ID 可见，调用方需自行处理 ID 空洞
+func (m *customOutboxModel) FindSince(ctx context.Context, after int64, limit int) ([]*Outbox, error) {
+	query := fmt.Sprintf("select %s from %s where id > $1 order by id limit $2", outboxRows, m.table)
+	var resp []*Outbox
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, after, limit)
+	return resp, err
+}
+
+// FindRevisionRange 查询保留的最早与最新事件 ID，发件箱为空时均为 0
+func (m *customOutboxModel) FindRevisionRange(ctx context.Context) (oldest, latest int64, err error) {
+	var row struct {
+		Oldest int64 `db:"oldest"`
+		Latest int64 `db:"latest"`
+	}
+	query := fmt.Sprintf("select COALESCE(MIN(id), 0) as oldest, COALESCE(MAX(id), 0) as latest from %s", m.table)
+	if err := m.conn.QueryRowCtx(ctx, &row, query); err != nil {
+		return 0, 0, err
+	}
+	return row.Oldest, row.Latest, nil
+}
+
+// organizationSnapshot 事件载荷，即 to_jsonb 生成的组织行
+type organizationSnapshot struct {
+	Id         int64           `json:"id"`
+	ParentId   *int64          `json:"parent_id"`
+	Name       string          `json:"name"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+	DisabledAt *time.Time      `json:"disabled_at"`
+	DeletedAt  *time.Time      `json:"deleted_at"`
+	NameKey    string          `json:"name_key"`
+	Path       string          `json:"path"`
+	OrgType    string          `json:"org_type"`
+	Code       string          `json:"code"`
+	Attributes json.RawMessage `json:"attributes"`
+	SortOrder  int64           `json:"sort_order"`
+}
+
+// Snapshot 解析事件载荷中的组织快照
+func (e *Outbox) Snapshot() (*Organizations, error) {
+	var s organizationSnapshot
+	if err := json.Unmarshal([]byte(e.Payload), &s); err != nil {
+		return nil, fmt.Errorf("outbox %d: %w", e.Id, err)
+	}
+	org := &Organizations{
+		Id:         s.Id,
+		Name:       s.Name,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+		NameKey:    s.NameKey,
+		Path:       s.Path,
+		OrgType:    s.OrgType,
+		Code:       s.Code,
+		Attributes: string(s.Attributes),
+		SortOrder:  s.SortOrder,
+	}
+	if s.ParentId != nil {
+		org.ParentId = sql.NullInt64{Valid: true, Int64: *s.ParentId}
+	}
+	if s.DisabledAt != nil {
+		org.DisabledAt = sql.NullTime{Valid: true, Time: *s.DisabledAt}
+	}
+	if s.DeletedAt != nil {
+		org.DeletedAt = sql.NullTime{Valid: true, Time: *s.DeletedAt}
+	}
+	return org, nil
+}
+
+// recordChanges 在组织变更的事务中为 ids 中的组织各写入一条 changeType 事件，载荷为组织当前行的快照
+// 事件按物化路径排序，级联变更中父节点的事件先于子节点
+func recordChanges(ctx context.Context, session sqlx.Session, changeType string, ids ...int64) error {
+	return recordEvents(ctx, session, changeType, sql.NullInt64{}, ids)
+}
+
+// recordMoves 在移动组织的事务中为 ids 中的组织各写入一条 moved 事件，previousParentId 为移动前的父节点
+func recordMoves(ctx context.Context, session sqlx.Session, previousParentId sql.NullInt64, ids ...int64) error {
+	return recordEvents(ctx, session, ChangeMoved, previousParentId, ids)
+}
+
+func recordEvents(ctx context.Context, session sqlx.Session, changeType string, previousParentId sql.NullInt64, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	query := fmt.Sprintf(`insert into %s (event_type, organization_id, previous_parent_id, payload)
+select $1, o.id, $3::bigint, to_jsonb(o) from %s o where o.id = any($2) order by o.path`, outboxTable, organizationsTable)
+	_, err := session.ExecCtx(ctx, query, changeType, pq.Array(ids), previousParentId)
+	return err
+}
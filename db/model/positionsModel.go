@@ -0,0 +1,110 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PositionsModel = (*customPositionsModel)(nil)
+
+type (
+	// PositionsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customPositionsModel.
+	PositionsModel interface {
+		positionsModel
+		FindByOrganizationId(ctx context.Context, organizationId int64) ([]*Positions, error) // 查询组织的全部职位，负责人、副职在前
+		FindHeads(ctx context.Context, organizationIds []int64) ([]*Positions, error)         // 批量查询组织的在任负责人（跳过空缺）
+	}
+
+	customPositionsModel struct {
+		*defaultPositionsModel
+	}
+)
+
+// 职位类型
+const (
+	PositionKindHead   = "head"   // 负责人，每个组织至多一个
+	PositionKindDeputy = "deputy" // 副职
+	PositionKindCustom = "custom" // 自定义职位
+)
+
+// headPositionIndex 每个组织至多一个负责人职位
+const headPositionIndex = "uk_position_head"
+
+// NewPositionsModel returns a model for the database table.
+func NewPositionsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) PositionsModel {
+	return &customPositionsModel{
+		defaultPositionsModel: newPositionsModel(conn, c, opts...),
+	}
+}
+
+// Insert 重写Insert方法，在审计事务中写入并使用RETURNING子句获取插入后的ID，组织已有负责人时返回 ErrDuplicateHead
+func (m *customPositionsModel) Insert(ctx context.Context, data *Positions) (sql.Result, error) {
+	var insertedID int64
+	err := auditTransact(ctx, m, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4) RETURNING id", m.table, positionsRowsExpectAutoSet)
+		return session.QueryRowCtx(ctx, &insertedID, query, data.OrganizationId, data.Kind, data.Title, data.UserId)
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == headPositionIndex {
+			return nil, ErrDuplicateHead
+		}
+		return nil, err
+	}
+
+	data.Id = insertedID
+	return &customResult{insertedID: insertedID}, m.DelCacheCtx(ctx, fmt.Sprintf("%s%v", cacheOrgPositionsIdPrefix, insertedID))
+}
+
+// Update 重写Update方法，在审计事务中更新
+func (m *customPositionsModel) Update(ctx context.Context, data *Positions) error {
+	err := auditTransact(ctx, m, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("update %s set %s where id = $1", m.table, positionsRowsWithPlaceHolder)
+		_, err := session.ExecCtx(ctx, query, data.Id, data.OrganizationId, data.Kind, data.Title, data.UserId)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return m.DelCacheCtx(ctx, fmt.Sprintf("%s%v", cacheOrgPositionsIdPrefix, data.Id))
+}
+
+// Delete 重写Delete方法，在审计事务中删除
+func (m *customPositionsModel) Delete(ctx context.Context, id int64) error {
+	err := auditTransact(ctx, m, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("delete from %s where id = $1", m.table)
+		_, err := session.ExecCtx(ctx, query, id)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return m.DelCacheCtx(ctx, fmt.Sprintf("%s%v", cacheOrgPositionsIdPrefix, id))
+}
+
+// FindByOrganizationId 查询组织的全部职位，按负责人、副职、自定义职位排序
+func (m *customPositionsModel) FindByOrganizationId(ctx context.Context, organizationId int64) ([]*Positions, error) {
+	query := fmt.Sprintf(`select %s from %s where organization_id = $1
+order by case kind when '%s' then 0 when '%s' then 1 else 2 end, id`, positionsRows, m.table, PositionKindHead, PositionKindDeputy)
+	var resp []*Positions
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, organizationId)
+	return resp, err
+}
+
+// FindHeads 批量查询组织的负责人职位，空缺（user_id 为 NULL）的职位不返回
+func (m *customPositionsModel) FindHeads(ctx context.Context, organizationIds []int64) ([]*Positions, error) {
+	if len(organizationIds) == 0 {
+		return nil, nil
+	}
+	query := fmt.Sprintf("select %s from %s where organization_id = any($1) and kind = $2 and user_id IS NOT NULL", positionsRows, m.table)
+	var resp []*Positions
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, pq.Array(organizationIds), PositionKindHead)
+	return resp, err
+}
@@ -1,5 +1,33 @@
 package model
 
-import "github.com/zeromicro/go-zero/core/stores/sqlx"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
 
 var ErrNotFound = sqlx.ErrNotFound
+
+var (
+	ErrInvalidParent    = errors.New("organization cannot be its own parent")                 // 父节点不能是自身
+	ErrCyclicParent     = errors.New("new parent is a descendant of the organization")        // 新父节点是自身的后代，会形成环
+	ErrParentNotActive  = errors.New("parent organization is deleted, disabled or absent")    // 父节点不存在或不可用
+	ErrDuplicateName    = errors.New("organization name already exists among siblings")       // 同级组织名称已存在
+	ErrTreeTooDeep      = errors.New("organization tree exceeds the maximum depth")           // 组织层级超过遍历上限
+	ErrHasChildren      = errors.New("organization has undeleted children")                   // 存在未删除的子组织
+	ErrDuplicateCode    = errors.New("organization code already exists")                      // 组织编码已存在
+	ErrInvalidPlacement = errors.New("placement sibling is absent or has a different parent") // 参照的兄弟节点不存在或不在同一父节点下
+	ErrInvalidOrder     = errors.New("ordered ids must list every child exactly once")        // 重排序列必须恰好包含全部子组织
+	ErrAlreadyMember    = errors.New("user is already a member of the organization")          // 用户已是该组织成员
+	ErrDuplicateHead    = errors.New("organization already has a head position")              // 组织已有负责人职位
+)
+
+// CycleError 遍历组织树时检测到 parent_id 循环引用
+type CycleError struct {
+	Id int64 // 环上重复出现的组织 ID
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("organization tree contains a cycle at id %d", e.Id)
+}
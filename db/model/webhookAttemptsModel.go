@@ -0,0 +1,83 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ WebhookAttemptsModel = (*customWebhookAttemptsModel)(nil)
+
+type (
+	// WebhookAttemptsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customWebhookAttemptsModel.
+	WebhookAttemptsModel interface {
+		webhookAttemptsModel
+		withSession(session sqlx.Session) WebhookAttemptsModel
+		FindPage(ctx context.Context, filter *WebhookAttemptsFilter, limit int64) ([]*WebhookAttemptDetail, error) // 按 ID 倒序分页查询投递尝试
+	}
+
+	customWebhookAttemptsModel struct {
+		*defaultWebhookAttemptsModel
+	}
+
+	// WebhookAttemptsFilter 投递尝试查询条件
+	WebhookAttemptsFilter struct {
+		WebhookId      int64  // 订阅 ID
+		DeliveryStatus string // 投递状态；为空表示不过滤
+		BeforeId       int64  // 仅查询 ID 小于该值的记录；0 表示从最新开始
+	}
+
+	// WebhookAttemptDetail 投递尝试及其所属投递的事件与状态
+	WebhookAttemptDetail struct {
+		Id             int64          `db:"id"`
+		DeliveryId     int64          `db:"delivery_id"`
+		WebhookId      int64          `db:"webhook_id"`
+		Attempt        int64          `db:"attempt"`
+		StatusCode     int64          `db:"status_code"`
+		Error          sql.NullString `db:"error"`
+		DurationMs     int64          `db:"duration_ms"`
+		CreatedAt      time.Time      `db:"created_at"`
+		EventId        int64          `db:"event_id"`
+		EventType      string         `db:"event_type"`
+		DeliveryStatus string         `db:"delivery_status"`
+	}
+)
+
+// NewWebhookAttemptsModel returns a model for the database table.
+func NewWebhookAttemptsModel(conn sqlx.SqlConn) WebhookAttemptsModel {
+	return &customWebhookAttemptsModel{
+		defaultWebhookAttemptsModel: newWebhookAttemptsModel(conn),
+	}
+}
+
+func (m *customWebhookAttemptsModel) withSession(session sqlx.Session) WebhookAttemptsModel {
+	return NewWebhookAttemptsModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// FindPage 按 ID 倒序查询订阅的投递尝试，以上一页最后一条的 ID 作为 BeforeId 翻页
+func (m *customWebhookAttemptsModel) FindPage(ctx context.Context, filter *WebhookAttemptsFilter, limit int64) ([]*WebhookAttemptDetail, error) {
+	conds := []string{"a.webhook_id = $1"}
+	args := []any{filter.WebhookId}
+	if filter.DeliveryStatus != "" {
+		args = append(args, filter.DeliveryStatus)
+		conds = append(conds, fmt.Sprintf("d.status = $%d", len(args)))
+	}
+	if filter.BeforeId > 0 {
+		args = append(args, filter.BeforeId)
+		conds = append(conds, fmt.Sprintf("a.id < $%d", len(args)))
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`select a.id, a.delivery_id, a.webhook_id, a.attempt, a.status_code, a.error, a.duration_ms, a.created_at,
+d.event_id, d.event_type, d.status as delivery_status
+from %s a join "org"."webhook_deliveries" d on d.id = a.delivery_id
+where %s order by a.id desc limit $%d`, m.table, strings.Join(conds, " and "), len(args))
+	var resp []*WebhookAttemptDetail
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, args...)
+	return resp, err
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ WebhookDeliveriesModel = (*customWebhookDeliveriesModel)(nil)
+
+type (
+	// WebhookDeliveriesModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customWebhookDeliveriesModel.
+	WebhookDeliveriesModel interface {
+		webhookDeliveriesModel
+		withSession(session sqlx.Session) WebhookDeliveriesModel
+		Enqueue(ctx context.Context, deliveries []*WebhookDeliveries) error                                                // 批量创建待投递记录
+		ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*WebhookDeliveries, error)                        // 领取到期的待投递记录
+		RecordAttempt(ctx context.Context, delivery *WebhookDeliveries, attempt *WebhookAttempts, retryAt time.Time) error // 记录一次投递尝试并更新投递状态
+	}
+
+	customWebhookDeliveriesModel struct {
+		*defaultWebhookDeliveriesModel
+	}
+)
+
+// Webhook 投递状态，与表 org.webhook_deliveries 的 status 取值一致
+const (
+	DeliveryPending   = "pending"   // 待投递，失败后按退避重试
+	DeliveryDelivered = "delivered" // 已投递
+	DeliveryDead      = "dead"      // 重试耗尽，不再投递
+)
+
+// webhookAttemptsTable Webhook 投递尝试记录
+const webhookAttemptsTable = `"org"."webhook_attempts"`
+
+// NewWebhookDeliveriesModel returns a model for the database table.
+func NewWebhookDeliveriesModel(conn sqlx.SqlConn) WebhookDeliveriesModel {
+	return &customWebhookDeliveriesModel{
+		defaultWebhookDeliveriesModel: newWebhookDeliveriesModel(conn),
+	}
+}
+
+func (m *customWebhookDeliveriesModel) withSession(session sqlx.Session) WebhookDeliveriesModel {
+	return NewWebhookDeliveriesModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// Enqueue 批量创建待投递记录；同一事件在同一订阅下已存在投递记录时忽略
+func (m *customWebhookDeliveriesModel) Enqueue(ctx context.Context, deliveries []*WebhookDeliveries) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	webhookIds := make([]int64, 0, len(deliveries))
+	eventIds := make([]int64, 0, len(deliveries))
+	eventTypes := make([]string, 0, len(deliveries))
+	payloads := make([]string, 0, len(deliveries))
+	for _, d := range deliveries {
+		webhookIds = append(webhookIds, d.WebhookId)
+		eventIds = append(eventIds, d.EventId)
+		eventTypes = append(eventTypes, d.EventType)
+		payloads = append(payloads, d.Payload)
+	}
+	query := fmt.Sprintf(`insert into %s (webhook_id, event_id, event_type, payload)
+select * from unnest($1::bigint[], $2::bigint[], $3::varchar[], $4::text[]) on conflict (webhook_id, event_id) do nothing`, m.table)
+	_, err := m.conn.ExecCtx(ctx, query, pq.Array(webhookIds), pq.Array(eventIds), pq.Array(eventTypes), pq.Array(payloads))
+	return err
+}
+
+// ClaimDue 领取至多 limit 条到期的待投递记录，并将其下次投递时间推迟 lease，
+// 领取方在 lease 内未记录结果（如进程退出）时，记录到期后会被重新领取
+func (m *customWebhookDeliveriesModel) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*WebhookDeliveries, error) {
+	query := fmt.Sprintf(`update %[1]s set next_attempt_at = $2 where id in (
+select id from %[1]s where status = $3 and next_attempt_at <= NOW() order by next_attempt_at, id limit $1 for update skip locked)
+returning %[2]s`, m.table, webhookDeliveriesRows)
+	var resp []*WebhookDeliveries
+	err := m.conn.QueryRowsCtx(ctx, &resp, query, limit, time.Now().Add(lease), DeliveryPending)
+	return resp, err
+}
+
+// RecordAttempt 在一个事务中写入投递尝试记录，并按 delivery 的 Status 更新投递：
+// 成功时标记投递时间，仍待投递时推迟到 retryAt
+func (m *customWebhookDeliveriesModel) RecordAttempt(ctx context.Context, delivery *WebhookDeliveries, attempt *WebhookAttempts, retryAt time.Time) error {
+	return m.conn.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5, $6)", webhookAttemptsTable, webhookAttemptsRowsExpectAutoSet)
+		_, err := session.ExecCtx(ctx, query, attempt.DeliveryId, attempt.WebhookId, attempt.Attempt, attempt.StatusCode, attempt.Error, attempt.DurationMs)
+		if err != nil {
+			return err
+		}
+
+		deliveredAt := sql.NullTime{Valid: delivery.Status == DeliveryDelivered, Time: time.Now()}
+		query = fmt.Sprintf(`update %s set status = $2, attempts = $3, next_attempt_at = $4, last_error = $5, delivered_at = $6
+where id = $1`, m.table)
+		_, err = session.ExecCtx(ctx, query, delivery.Id, delivery.Status, attempt.Attempt, retryAt, attempt.Error, deliveredAt)
+		return err
+	})
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ WebhooksModel = (*customWebhooksModel)(nil)
+
+type (
+	// WebhooksModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customWebhooksModel.
+	WebhooksModel interface {
+		webhooksModel
+		withSession(session sqlx.Session) WebhooksModel
+		FindAll(ctx context.Context) ([]*Webhooks, error) // 查询全部订阅
+	}
+
+	customWebhooksModel struct {
+		*defaultWebhooksModel
+	}
+)
+
+// NewWebhooksModel returns a model for the database table.
+func NewWebhooksModel(conn sqlx.SqlConn) WebhooksModel {
+	return &customWebhooksModel{
+		defaultWebhooksModel: newWebhooksModel(conn),
+	}
+}
+
+func (m *customWebhooksModel) withSession(session sqlx.Session) WebhooksModel {
+	return NewWebhooksModel(sqlx.NewSqlConnFromSession(session))
+}
+
+// Insert 重写Insert方法，在审计事务中写入并使用RETURNING子句获取插入后的ID
+func (m *customWebhooksModel) Insert(ctx context.Context, data *Webhooks) (sql.Result, error) {
+	var insertedID int64
+	err := auditTransact(ctx, m.conn, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("insert into %s (%s) values ($1, $2, $3, $4, $5) RETURNING id", m.table, webhooksRowsExpectAutoSet)
+		return session.QueryRowCtx(ctx, &insertedID, query, data.Url, data.EventTypes, data.RootOrganizationId, data.Secret, data.Description)
+	})
+	if err != nil {
+		return nil, err
+	}
+	data.Id = insertedID
+	return &customResult{insertedID: insertedID}, nil
+}
+
+// Delete 重写Delete方法，在审计事务中删除订阅
+func (m *customWebhooksModel) Delete(ctx context.Context, id int64) error {
+	return auditTransact(ctx, m.conn, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("delete from %s where id = $1", m.table)
+		_, err := session.ExecCtx(ctx, query, id)
+		return err
+	})
+}
+
+// FindAll 按 ID 顺序查询全部订阅
+func (m *customWebhooksModel) FindAll(ctx context.Context) ([]*Webhooks, error) {
+	query := fmt.Sprintf("select %s from %s order by id", webhooksRows, m.table)
+	var resp []*Webhooks
+	err := m.conn.QueryRowsCtx(ctx, &resp, query)
+	return resp, err
+}
@@ -0,0 +1,105 @@
+package attribute
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/ziptako/organization/internal/config"
+)
+
+// 属性值类型
+const (
+	TypeString = "string"
+	TypeNumber = "number"
+	TypeBool   = "bool"
+)
+
+// Schema 组织扩展属性规则，按配置的属性定义校验组织的 attributes
+type Schema struct {
+	defs map[string]config.AttributeDef
+}
+
+// NewSchema 根据属性定义创建校验规则
+func NewSchema(defs []config.AttributeDef) *Schema {
+	s := &Schema{
+		defs: make(map[string]config.AttributeDef, len(defs)),
+	}
+	for _, def := range defs {
+		s.defs[def.Key] = def
+	}
+	return s
+}
+
+// Validate 校验 orgType 类型组织的属性，attrs 为 structpb.Struct.AsMap 的结果
+// 未定义任何属性时不做校验；否则拒绝未定义的属性、不适用于该组织类型的属性，并检查必填、类型与取值范围
+func (s *Schema) Validate(orgType string, attrs map[string]any) error {
+	if len(s.defs) == 0 {
+		return nil
+	}
+
+	// 按属性名排序，保证错误信息稳定
+	keys := make([]string, 0, len(attrs))
+	for key := range attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		def, ok := s.defs[key]
+		if !ok {
+			return fmt.Errorf("属性 %s 未定义", key)
+		}
+		if !applies(def, orgType) {
+			return fmt.Errorf("属性 %s 不适用于组织类型 %s", key, orgType)
+		}
+		if err := checkValue(def, attrs[key]); err != nil {
+			return err
+		}
+	}
+
+	required := make([]string, 0, len(s.defs))
+	for key, def := range s.defs {
+		if _, ok := attrs[key]; !ok && def.Required && applies(def, orgType) {
+			required = append(required, key)
+		}
+	}
+	if len(required) > 0 {
+		sort.Strings(required)
+		return fmt.Errorf("缺少必填属性 %v", required)
+	}
+	return nil
+}
+
+// applies 判断属性是否适用于 orgType 类型的组织
+func applies(def config.AttributeDef, orgType string) bool {
+	return len(def.OrgTypes) == 0 || slices.Contains(def.OrgTypes, orgType)
+}
+
+// checkValue 检查属性值的类型与取值范围
+func checkValue(def config.AttributeDef, value any) error {
+	var text string
+	switch v := value.(type) {
+	case string:
+		if def.Type != TypeString {
+			return fmt.Errorf("属性 %s 应为 %s 类型", def.Key, def.Type)
+		}
+		text = v
+	case float64:
+		if def.Type != TypeNumber {
+			return fmt.Errorf("属性 %s 应为 %s 类型", def.Key, def.Type)
+		}
+		text = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		if def.Type != TypeBool {
+			return fmt.Errorf("属性 %s 应为 %s 类型", def.Key, def.Type)
+		}
+		text = strconv.FormatBool(v)
+	default:
+		return fmt.Errorf("属性 %s 应为 %s 类型", def.Key, def.Type)
+	}
+	if len(def.Enum) > 0 && !slices.Contains(def.Enum, text) {
+		return fmt.Errorf("属性 %s 的取值 %s 不在允许范围 %v 内", def.Key, text, def.Enum)
+	}
+	return nil
+}
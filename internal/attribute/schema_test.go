@@ -0,0 +1,60 @@
+package attribute
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ziptako/organization/internal/config"
+)
+
+func TestSchemaValidate(t *testing.T) {
+	schema := NewSchema([]config.AttributeDef{
+		{Key: "cost_center", Type: TypeString, Required: true, OrgTypes: []string{"company", "department"}},
+		{Key: "location", Type: TypeString},
+		{Key: "level", Type: TypeNumber, Enum: []string{"1", "2", "3"}},
+		{Key: "headcount", Type: TypeNumber},
+		{Key: "virtual", Type: TypeBool},
+	})
+
+	tests := []struct {
+		name    string
+		orgType string
+		attrs   map[string]any
+		wantErr string // 为空表示应通过校验
+	}{
+		{name: "valid", orgType: "department", attrs: map[string]any{"cost_center": "CC-01", "level": float64(2), "virtual": true}},
+		{name: "missing required", orgType: "department", attrs: map[string]any{"location": "上海"}, wantErr: "缺少必填属性 [cost_center]"},
+		{name: "required not applicable", orgType: "team", attrs: map[string]any{"location": "上海"}},
+		{name: "not applicable to org type", orgType: "team", attrs: map[string]any{"cost_center": "CC-01"}, wantErr: "不适用于组织类型 team"},
+		{name: "unknown key", orgType: "department", attrs: map[string]any{"cost_center": "CC-01", "owner": "x"}, wantErr: "属性 owner 未定义"},
+		{name: "string type mismatch", orgType: "department", attrs: map[string]any{"cost_center": float64(1)}, wantErr: "属性 cost_center 应为 string 类型"},
+		{name: "number", orgType: "team", attrs: map[string]any{"headcount": float64(12.5)}},
+		{name: "number type mismatch", orgType: "team", attrs: map[string]any{"headcount": "12"}, wantErr: "属性 headcount 应为 number 类型"},
+		{name: "bool type mismatch", orgType: "team", attrs: map[string]any{"virtual": "true"}, wantErr: "属性 virtual 应为 bool 类型"},
+		{name: "enum allowed", orgType: "team", attrs: map[string]any{"level": float64(3)}},
+		{name: "enum rejected", orgType: "team", attrs: map[string]any{"level": float64(4)}, wantErr: "取值 4 不在允许范围"},
+		{name: "enum non integer", orgType: "team", attrs: map[string]any{"level": 1.5}, wantErr: "取值 1.5 不在允许范围"},
+		{name: "null value", orgType: "team", attrs: map[string]any{"location": nil}, wantErr: "属性 location 应为 string 类型"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Validate(tt.orgType, tt.attrs)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateWithoutDefs(t *testing.T) {
+	// 未定义任何属性时不做校验
+	if err := NewSchema(nil).Validate("team", map[string]any{"anything": []any{1}}); err != nil {
+		t.Fatalf("Validate() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"context"
+	"path"
+	"unicode/utf8"
+
+	"github.com/zeromicro/go-zero/core/trace"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// 请求元数据中的审计信息
+const (
+	MetadataActor     = "x-actor"      // 操作人，未启用认证时使用；启用认证时以令牌的 sub 为准
+	MetadataRequestId = "x-request-id" // 请求 ID，缺省使用链路追踪 ID
+)
+
+// 审计日志中各字段的最大长度（字符数），与表 org.audit_log 的列定义一致，超出部分截断，避免写入失败导致变更回滚
+const (
+	maxActorLength     = 128
+	maxActionLength    = 64
+	maxRequestIdLength = 128
+)
+
+// UnaryInterceptor 将操作人、接口名与请求 ID 写入 context，修改组织的事务据此写入审计日志；需在认证拦截器之后注册
+func UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(model.WithAuditInfo(ctx, auditInfo(ctx, info.FullMethod)), req)
+}
+
+// auditInfo 从认证信息与请求元数据中提取调用信息
+func auditInfo(ctx context.Context, method string) *model.AuditInfo {
+	info := &model.AuditInfo{
+		Action: path.Base(method),
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if p, ok := auth.FromContext(ctx); ok {
+		info.Actor = p.Subject
+	} else if values := md.Get(MetadataActor); len(values) > 0 {
+		info.Actor = values[0]
+	}
+	if values := md.Get(MetadataRequestId); len(values) > 0 {
+		info.RequestId = values[0]
+	} else {
+		info.RequestId = trace.TraceIDFromContext(ctx)
+	}
+	info.Actor = truncate(info.Actor, maxActorLength)
+	info.Action = truncate(info.Action, maxActionLength)
+	info.RequestId = truncate(info.RequestId, maxRequestIdLength)
+	return info
+}
+
+// truncate 截取 s 的前 n 个字符
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
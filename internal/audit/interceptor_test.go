@@ -0,0 +1,85 @@
+package audit
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/auth"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuditInfo(t *testing.T) {
+	const method = "/organization.organizationService/UpdateOrganization"
+	traceId := oteltrace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	traced := oteltrace.ContextWithSpanContext(context.Background(), oteltrace.NewSpanContext(oteltrace.SpanContextConfig{
+		TraceID: traceId,
+		SpanID:  oteltrace.SpanID{0, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+	}))
+	long := strings.Repeat("长", maxActorLength+10)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		principal *auth.Principal
+		md        metadata.MD
+		want      model.AuditInfo
+	}{
+		{
+			name: "metadata",
+			ctx:  context.Background(),
+			md:   metadata.Pairs(MetadataActor, "alice", MetadataRequestId, "req-1"),
+			want: model.AuditInfo{Actor: "alice", Action: "UpdateOrganization", RequestId: "req-1"},
+		},
+		{
+			name:      "principal beats x-actor",
+			ctx:       context.Background(),
+			principal: &auth.Principal{Subject: "42"},
+			md:        metadata.Pairs(MetadataActor, "alice", MetadataRequestId, "req-1"),
+			want:      model.AuditInfo{Actor: "42", Action: "UpdateOrganization", RequestId: "req-1"},
+		},
+		{
+			name: "trace id without x-request-id",
+			ctx:  traced,
+			md:   metadata.Pairs(MetadataActor, "alice"),
+			want: model.AuditInfo{Actor: "alice", Action: "UpdateOrganization", RequestId: traceId.String()},
+		},
+		{
+			name: "x-request-id beats trace id",
+			ctx:  traced,
+			md:   metadata.Pairs(MetadataRequestId, "req-1"),
+			want: model.AuditInfo{Action: "UpdateOrganization", RequestId: "req-1"},
+		},
+		{
+			name: "no metadata",
+			ctx:  context.Background(),
+			want: model.AuditInfo{Action: "UpdateOrganization"},
+		},
+		{
+			name: "truncated",
+			ctx:  context.Background(),
+			md:   metadata.Pairs(MetadataActor, long, MetadataRequestId, long),
+			want: model.AuditInfo{
+				Actor:     long[:maxActorLength*len("长")],
+				Action:    "UpdateOrganization",
+				RequestId: long[:maxRequestIdLength*len("长")],
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			if tt.principal != nil {
+				ctx = auth.NewContext(ctx, tt.principal)
+			}
+			if got := auditInfo(ctx, method); *got != tt.want {
+				t.Errorf("auditInfo() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/ziptako/organization/internal/config"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testIssuer = "https://issuer.test"
+
+// issuer 测试用的本地令牌签发方，公钥以 JWKS 文件提供给认证器
+type issuer struct {
+	key  *rsa.PrivateKey
+	kid  string
+	file string
+}
+
+func newIssuer(t *testing.T) *issuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iss := &issuer{key: key, kid: "test-key", file: filepath.Join(t.TempDir(), "jwks.json")}
+	jwks := map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": iss.kid,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	}
+	content, _ := json.Marshal(jwks)
+	if err := os.WriteFile(iss.file, content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return iss
+}
+
+// sign 签发令牌，默认签发者为 testIssuer、一小时后过期
+func (iss *issuer) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	all := jwt.MapClaims{
+		"iss": testIssuer,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range claims {
+		if v == nil {
+			delete(all, k)
+			continue
+		}
+		all[k] = v
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, all)
+	token.Header["kid"] = iss.kid
+	signed, err := token.SignedString(iss.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestAuthenticateJwks(t *testing.T) {
+	iss := newIssuer(t)
+	other := newIssuer(t)
+	a, err := NewAuthenticator(config.AuthConf{JwksFile: iss.file, Issuer: testIssuer, RolesClaim: "roles"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := a.Authenticate(iss.sign(t, jwt.MapClaims{"sub": "42", "roles": []string{RoleAdmin}}))
+	if err != nil {
+		t.Fatalf("valid token: %v", err)
+	}
+	if p.Subject != "42" || !p.HasAnyRole(RoleAdmin) {
+		t.Fatalf("principal = %+v", p)
+	}
+
+	hmac, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": testIssuer,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte("secret"))
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "other key", token: other.sign(t, jwt.MapClaims{"sub": "42"})},
+		{name: "expired", token: iss.sign(t, jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()})},
+		{name: "no exp", token: iss.sign(t, jwt.MapClaims{"exp": nil})},
+		{name: "wrong issuer", token: iss.sign(t, jwt.MapClaims{"iss": "https://other.test"})},
+		{name: "hmac without secret", token: hmac},
+		{name: "garbage", token: "not-a-token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := a.Authenticate(tt.token); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestAuthenticateSecretRoleMapping(t *testing.T) {
+	a, err := NewAuthenticator(config.AuthConf{
+		Secret:      "secret",
+		RolesClaim:  "realm_access.roles",
+		RoleMapping: map[string]string{"org-admins": RoleAdmin},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":          "7",
+		"exp":          time.Now().Add(time.Hour).Unix(),
+		"realm_access": map[string]any{"roles": []string{"org-admins", "other"}},
+	}).SignedString([]byte("secret"))
+
+	p, err := a.Authenticate(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Roles) != 2 || p.Roles[0] != RoleAdmin || p.Roles[1] != "other" {
+		t.Fatalf("roles = %v", p.Roles)
+	}
+}
+
+func TestUnaryInterceptors(t *testing.T) {
+	iss := newIssuer(t)
+	c := config.AuthConf{
+		JwksFile:   iss.file,
+		RolesClaim: "roles",
+		Permissions: map[string][]string{
+			organization.OrganizationService_GetAncestors_FullMethodName: {},
+		},
+	}
+	interceptors := MustUnaryInterceptors(c)
+	// chain 依次执行两个拦截器，返回到达业务处理时调用方是否被标记为按授权检查
+	chain := func(ctx context.Context, method string) (bool, error) {
+		var handled, scoped bool
+		handler := func(ctx context.Context, req any) (any, error) {
+			handled = true
+			if p, ok := FromContext(ctx); ok {
+				scoped = p.Scoped
+			}
+			return nil, nil
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		_, err := interceptors[0](ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+			return interceptors[1](ctx, req, info, handler)
+		})
+		if err == nil && !handled {
+			t.Fatalf("%s: handler not called", method)
+		}
+		return scoped, err
+	}
+	withToken := func(roles ...string) context.Context {
+		token := iss.sign(t, jwt.MapClaims{"sub": "1", "roles": roles})
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		code   codes.Code
+		scoped bool
+	}{
+		{name: "no token", ctx: context.Background(), method: organization.OrganizationService_GetOrganization_FullMethodName, code: codes.Unauthenticated},
+		{name: "viewer reads", ctx: withToken(RoleViewer), method: organization.OrganizationService_GetOrganization_FullMethodName, code: codes.OK},
+		{name: "viewer adds member", ctx: withToken(RoleViewer), method: organization.MembershipService_AddMember_FullMethodName, code: codes.PermissionDenied},
+		{name: "editor grants", ctx: withToken(RoleEditor), method: organization.AdminGrantService_GrantAdmin_FullMethodName, code: codes.PermissionDenied},
+		// 移动、删除仅限全局管理员；其他调用方放行但标记为按组织管理授权检查
+		{name: "editor creates", ctx: withToken(RoleEditor), method: organization.OrganizationService_CreateOrganization_FullMethodName, code: codes.OK},
+		{name: "editor moves", ctx: withToken(RoleEditor), method: organization.OrganizationService_MoveOrganization_FullMethodName, code: codes.OK, scoped: true},
+		{name: "viewer deletes", ctx: withToken(RoleViewer), method: organization.OrganizationService_DeleteOrganization_FullMethodName, code: codes.OK, scoped: true},
+		{name: "admin deletes", ctx: withToken(RoleAdmin), method: organization.OrganizationService_DeleteOrganization_FullMethodName, code: codes.OK},
+		{name: "any role by config", ctx: withToken(), method: organization.OrganizationService_GetAncestors_FullMethodName, code: codes.OK},
+		{name: "unknown method", ctx: withToken(RoleAdmin), method: "/organization.organizationService/Unknown", code: codes.PermissionDenied},
+		{name: "health check", ctx: context.Background(), method: "/grpc.health.v1.Health/Check", code: codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scoped, err := chain(tt.ctx, tt.method)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %v, want %v", code, tt.code)
+			}
+			if scoped != tt.scoped {
+				t.Fatalf("scoped = %v, want %v", scoped, tt.scoped)
+			}
+		})
+	}
+}
+
+func TestStreamInterceptors(t *testing.T) {
+	iss := newIssuer(t)
+	interceptors := MustStreamInterceptors(config.AuthConf{JwksFile: iss.file, RolesClaim: "roles"})
+	chain := func(ctx context.Context, method string) (string, error) {
+		var subject string
+		handler := func(srv any, ss grpc.ServerStream) error {
+			if p, ok := FromContext(ss.Context()); ok {
+				subject = p.Subject
+			}
+			return nil
+		}
+		info := &grpc.StreamServerInfo{FullMethod: method, IsServerStream: true}
+		err := interceptors[0](nil, &serverStream{ctx: ctx}, info, func(srv any, ss grpc.ServerStream) error {
+			return interceptors[1](srv, ss, info, handler)
+		})
+		return subject, err
+	}
+	method := organization.OrganizationService_WatchOrganizations_FullMethodName
+
+	if _, err := chain(context.Background(), method); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("no token: code = %v, want %v", status.Code(err), codes.Unauthenticated)
+	}
+	token := iss.sign(t, jwt.MapClaims{"sub": "1", "roles": []string{RoleViewer}})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	subject, err := chain(ctx, method)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subject != "1" {
+		t.Fatalf("subject = %q, want %q", subject, "1")
+	}
+}
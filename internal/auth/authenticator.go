@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/ziptako/organization/internal/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errMissingToken = status.Error(codes.Unauthenticated, "[AU001] 缺少访问令牌")
+	errInvalidToken = status.Error(codes.Unauthenticated, "[AU002] 访问令牌无效或已过期")
+)
+
+// Authenticator 校验 bearer JWT 并将声明映射为调用方角色
+type Authenticator struct {
+	parser      *jwt.Parser
+	secret      []byte
+	keys        map[string]crypto.PublicKey
+	issuer      string
+	audience    string
+	rolesClaim  []string
+	roleMapping map[string]string
+}
+
+// NewAuthenticator 根据配置创建认证器，Secret 与 JwksFile 至少配置一个
+func NewAuthenticator(c config.AuthConf) (*Authenticator, error) {
+	a := &Authenticator{
+		secret:      []byte(c.Secret),
+		issuer:      c.Issuer,
+		audience:    c.Audience,
+		rolesClaim:  strings.Split(c.RolesClaim, "."),
+		roleMapping: c.RoleMapping,
+	}
+	if c.RolesClaim == "" {
+		a.rolesClaim = []string{"roles"}
+	}
+
+	var methods []string
+	if c.Secret != "" {
+		methods = append(methods, "HS256", "HS384", "HS512")
+	}
+	if c.JwksFile != "" {
+		keys, err := loadJwks(c.JwksFile)
+		if err != nil {
+			return nil, err
+		}
+		a.keys = keys
+		methods = append(methods, "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512")
+	}
+	if len(methods) == 0 {
+		return nil, errors.New("auth: Secret or JwksFile is required")
+	}
+	// 仅接受已配置密钥对应的算法，避免用公钥充当 HMAC 密钥等算法混淆
+	a.parser = jwt.NewParser(jwt.WithValidMethods(methods))
+	return a, nil
+}
+
+// Authenticate 校验令牌的签名、有效期、签发者与受众，返回调用方
+func (a *Authenticator) Authenticate(token string) (*Principal, error) {
+	claims := jwt.MapClaims{}
+	if _, err := a.parser.ParseWithClaims(token, claims, a.keyFunc); err != nil {
+		return nil, err
+	}
+	// MapClaims 仅在存在 exp 时校验有效期，这里要求必须设置
+	if _, ok := claims["exp"]; !ok {
+		return nil, errors.New("token has no exp claim")
+	}
+	if a.issuer != "" && !claims.VerifyIssuer(a.issuer, true) {
+		return nil, fmt.Errorf("unexpected issuer %v", claims["iss"])
+	}
+	if a.audience != "" && !claims.VerifyAudience(a.audience, true) {
+		return nil, fmt.Errorf("unexpected audience %v", claims["aud"])
+	}
+
+	subject, _ := claims["sub"].(string)
+	return &Principal{
+		Subject: subject,
+		Roles:   a.roles(claims),
+		Claims:  claims,
+	}, nil
+}
+
+// keyFunc 按签名算法选择密钥：HMAC 使用共享密钥，其余按 kid 在 JWKS 中查找；JWKS 只有一个密钥时允许省略 kid
+func (a *Authenticator) keyFunc(token *jwt.Token) (any, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		return a.secret, nil
+	}
+	kid, _ := token.Header["kid"].(string)
+	if key, ok := a.keys[kid]; ok {
+		return key, nil
+	}
+	if kid == "" && len(a.keys) == 1 {
+		for _, key := range a.keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+// roles 读取角色声明并按 RoleMapping 映射；声明可以是字符串数组，或以空格分隔的字符串（如 scope）
+func (a *Authenticator) roles(claims jwt.MapClaims) []string {
+	var value any = map[string]any(claims)
+	for _, name := range a.rolesClaim {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+
+	var values []string
+	switch v := value.(type) {
+	case string:
+		values = strings.Fields(v)
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+
+	roles := make([]string, 0, len(values))
+	for _, v := range values {
+		if role, ok := a.roleMapping[v]; ok {
+			v = role
+		}
+		roles = append(roles, v)
+	}
+	return roles
+}
+
+// UnaryInterceptor 从 authorization 元数据中读取 bearer 令牌，认证通过后将调用方写入 context
+func (a *Authenticator) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor 流式接口的认证，与 UnaryInterceptor 相同
+func (a *Authenticator) StreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticate 认证 method 的调用方，返回携带调用方信息的 context；无需认证的方法原样返回
+func (a *Authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if isPublicMethod(method) {
+		return ctx, nil
+	}
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return nil, errMissingToken
+	}
+	p, err := a.Authenticate(token)
+	if err != nil {
+		logx.WithContext(ctx).Infof("reject token for %s: %v", method, err)
+		return nil, errInvalidToken
+	}
+	return NewContext(ctx, p), nil
+}
+
+// serverStream 替换了 context 的 grpc.ServerStream
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// bearerToken 读取 authorization: Bearer <token>
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, value := range md.Get("authorization") {
+		scheme, token, found := strings.Cut(value, " ")
+		if found && strings.EqualFold(scheme, "bearer") && token != "" {
+			return strings.TrimSpace(token), true
+		}
+	}
+	return "", false
+}
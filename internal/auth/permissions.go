@@ -0,0 +1,63 @@
+package auth
+
+import "github.com/ziptako/organization/organization"
+
+var (
+	readers = []string{RoleViewer, RoleEditor, RoleAdmin}
+	writers = []string{RoleEditor, RoleAdmin}
+	admins  = []string{RoleAdmin}
+)
+
+// defaultPermissions 内置权限表：完整方法名到允许的角色；移动、删除组织仅限管理员
+var defaultPermissions = map[string][]string{
+	organization.OrganizationService_CreateOrganization_FullMethodName:          writers,
+	organization.OrganizationService_GetOrganization_FullMethodName:             readers,
+	organization.OrganizationService_UpdateOrganization_FullMethodName:          writers,
+	organization.OrganizationService_DeleteOrganization_FullMethodName:          admins,
+	organization.OrganizationService_ListOrganizations_FullMethodName:           readers,
+	organization.OrganizationService_GetAncestors_FullMethodName:                readers,
+	organization.OrganizationService_GetDescendants_FullMethodName:              readers,
+	organization.OrganizationService_MoveOrganization_FullMethodName:            admins,
+	organization.OrganizationService_DisableOrganization_FullMethodName:         writers,
+	organization.OrganizationService_EnableOrganization_FullMethodName:          writers,
+	organization.OrganizationService_RestoreOrganization_FullMethodName:         admins,
+	organization.OrganizationService_GetOrganizationByCode_FullMethodName:       readers,
+	organization.OrganizationService_BatchGetOrganizationsByCode_FullMethodName: readers,
+	organization.OrganizationService_ReorderChildren_FullMethodName:             writers,
+	organization.OrganizationService_ResolveDataScope_FullMethodName:            readers,
+	organization.OrganizationService_WatchOrganizations_FullMethodName:          readers,
+
+	organization.MembershipService_AddMember_FullMethodName:               writers,
+	organization.MembershipService_RemoveMember_FullMethodName:            writers,
+	organization.MembershipService_ListMembers_FullMethodName:             readers,
+	organization.MembershipService_ListOrganizationsOfUser_FullMethodName: readers,
+
+	organization.PositionService_CreatePosition_FullMethodName:  writers,
+	organization.PositionService_AssignPosition_FullMethodName:  writers,
+	organization.PositionService_DeletePosition_FullMethodName:  writers,
+	organization.PositionService_ListPositions_FullMethodName:   readers,
+	organization.PositionService_GetManagerChain_FullMethodName: readers,
+
+	organization.AdminGrantService_GrantAdmin_FullMethodName:      admins,
+	organization.AdminGrantService_RevokeAdmin_FullMethodName:     admins,
+	organization.AdminGrantService_ListAdminGrants_FullMethodName: admins,
+
+	organization.WebhookService_CreateWebhook_FullMethodName:       admins,
+	organization.WebhookService_ListWebhooks_FullMethodName:        admins,
+	organization.WebhookService_DeleteWebhook_FullMethodName:       admins,
+	organization.WebhookService_ListWebhookAttempts_FullMethodName: admins,
+
+	organization.AuditService_ListAuditLog_FullMethodName: admins,
+}
+
+// delegableMethods 可委派的方法：调用方缺少全局角色时，凭目标组织上的管理授权（org.admin_grants）调用
+var delegableMethods = map[string]struct{}{
+	organization.OrganizationService_CreateOrganization_FullMethodName:  {},
+	organization.OrganizationService_UpdateOrganization_FullMethodName:  {},
+	organization.OrganizationService_DeleteOrganization_FullMethodName:  {},
+	organization.OrganizationService_MoveOrganization_FullMethodName:    {},
+	organization.OrganizationService_DisableOrganization_FullMethodName: {},
+	organization.OrganizationService_EnableOrganization_FullMethodName:  {},
+	organization.OrganizationService_RestoreOrganization_FullMethodName: {},
+	organization.OrganizationService_ReorderChildren_FullMethodName:     {},
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"strconv"
+)
+
+// 内置角色
+const (
+	RoleAdmin  = "org:admin"  // 管理员：可执行全部操作，包括移动与删除组织
+	RoleEditor = "org:editor" // 编辑：可创建、修改组织及成员、职位
+	RoleViewer = "org:viewer" // 只读：仅可查询
+)
+
+// Principal 通过认证的调用方
+type Principal struct {
+	Subject string         // 令牌的 sub 声明
+	Roles   []string       // 由声明映射得到的角色
+	Claims  map[string]any // 令牌的全部声明
+	Scoped  bool           // 未持有接口要求的全局角色，凭组织管理授权通过鉴权，需由业务逻辑按目标组织检查授权
+}
+
+// UserId 将 sub 解析为用户 ID；sub 不是正整数时返回 false
+func (p *Principal) UserId() (int64, bool) {
+	id, err := strconv.ParseInt(p.Subject, 10, 64)
+	return id, err == nil && id > 0
+}
+
+// HasAnyRole 判断调用方是否拥有 roles 中的任一角色
+func (p *Principal) HasAnyRole(roles ...string) bool {
+	for _, role := range roles {
+		if slices.Contains(p.Roles, role) {
+			return true
+		}
+	}
+	return false
+}
+
+type principalKey struct{}
+
+// NewContext 返回携带调用方信息的 context
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext 获取 context 中的调用方信息；未启用认证时返回 false
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
@@ -1,12 +1,108 @@
 package config
 
 import (
+	"time"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
 	zrpc.RpcServerConf
-	DataSource string          // 数据库连接字符串
-	Cache      cache.CacheConf // 缓存配置
+	DataSource    string              // 数据库连接字符串
+	Cache         cache.CacheConf     // 缓存配置
+	NameNormalize NameNormalizeConf   // 组织名称规范化配置，用于同级重名校验
+	OrgType       OrgTypeConf         // 组织类型及层级规则
+	Code          CodeConf            // 组织编码规则
+	Attributes    []AttributeDef      `json:",optional"` // 组织扩展属性定义；未定义任何属性时不做校验
+	DataScope     DataScopeConf       // 数据权限范围解析配置
+	Auth          AuthConf            // 认证与接口鉴权配置
+	Outbox        OutboxConf          // 组织变更事件投递配置
+	Watch         WatchConf           // 组织树变更订阅配置
+	Webhooks      WebhookDeliveryConf // Webhook 订阅的投递配置
+}
+
+// NameNormalizeConf 组织名称规范化规则，修改后需执行 organization -rebuild-name-keys 重新生成已有组织的 name_key
+type NameNormalizeConf struct {
+	TrimSpace bool `json:",default=true"` // 去除首尾空白
+	FoldCase  bool `json:",default=true"` // 忽略大小写
+	FoldWidth bool `json:",default=true"` // 全角字符视为半角
+}
+
+// OrgTypeConf 组织类型层级规则
+type OrgTypeConf struct {
+	Default        string              `json:",default=department"` // 创建时未指定类型所使用的类型
+	RootTypes      []string            `json:",optional"`           // 允许作为根节点的类型
+	AllowedParents map[string][]string `json:",optional"`           // 各类型允许的父节点类型；未配置任何规则时不做层级校验
+}
+
+// CodeConf 组织编码规则
+type CodeConf struct {
+	Pattern string `json:",optional"` // 未指定编码时自动生成所用的模式，如 {type}-{seq:6}；为空时创建必须指定编码
+	Mutable bool   `json:",optional"` // 是否允许创建后修改编码
+}
+
+// AttributeDef 组织扩展属性定义
+type AttributeDef struct {
+	Key      string   // 属性名
+	Type     string   `json:",options=string|number|bool"` // 属性值类型
+	Required bool     `json:",optional"`                   // 是否必填
+	Enum     []string `json:",optional"`                   // 允许的取值；为空表示不限，number 类型按十进制文本比较
+	OrgTypes []string `json:",optional"`                   // 适用的组织类型；为空表示适用于所有类型
+}
+
+// DataScopeConf 数据权限范围解析配置
+type DataScopeConf struct {
+	CompactThreshold int `json:",default=1000"` // 可访问组织超过该数量时以 ID 区间返回；<=0 表示总是返回 ID 列表
+}
+
+// AuthConf 认证与接口鉴权配置，启用后每个请求需携带 authorization: Bearer <JWT>
+type AuthConf struct {
+	Enabled     bool                `json:",optional"`      // 是否启用认证与鉴权
+	Secret      string              `json:",optional"`      // HMAC（HS256/HS384/HS512）签名的共享密钥
+	JwksFile    string              `json:",optional"`      // 本地 JWKS 文件，用于校验 RSA/ECDSA 签名
+	Issuer      string              `json:",optional"`      // 要求的签发者（iss）；为空不校验
+	Audience    string              `json:",optional"`      // 要求的受众（aud）；为空不校验
+	RolesClaim  string              `json:",default=roles"` // 角色所在的声明，可用 . 访问嵌套声明，如 realm_access.roles
+	RoleMapping map[string]string   `json:",optional"`      // 声明取值到角色的映射，如 org-admins: org:admin；未映射的取值原样作为角色
+	Permissions map[string][]string `json:",optional"`      // 覆盖内置权限表：完整方法名到允许的角色，空列表表示任意已认证调用方
+}
+
+// OutboxConf 组织变更事件投递配置，事件与组织变更在同一事务中写入发件箱，由后台投递器异步发布
+type OutboxConf struct {
+	Enabled     bool          `json:",default=true"`                    // 是否启动投递器；多实例部署时各实例领取不同的事件
+	Interval    time.Duration `json:",default=1s"`                      // 轮询发件箱的间隔
+	BatchSize   int           `json:",default=100"`                     // 每批领取的事件数量
+	Lease       time.Duration `json:",default=5m"`                      // 领取一批事件的租期，租期内未投递完的事件到期后重新领取
+	MinBackoff  time.Duration `json:",default=1s"`                      // 投递失败后首次重试的等待时间，之后逐次翻倍
+	MaxBackoff  time.Duration `json:",default=5m"`                      // 重试等待时间的上限
+	MaxAttempts int64         `json:",default=20"`                      // 投递失败次数上限，达到后转为死信；<=0 表示不限
+	Retention   time.Duration `json:",default=168h"`                    // 投递成功及转为死信的事件保留时长；<=0 表示不清理
+	Publisher   string        `json:",default=log,options=log|webhook"` // 发布方式：log 写日志，webhook 以 HTTP POST 推送
+	Webhook     WebhookConf   `json:",optional"`                        // Publisher 为 webhook 时的推送配置
+}
+
+// WebhookConf 组织变更事件的 HTTP 推送配置
+type WebhookConf struct {
+	Url     string            `json:",optional"`   // 推送地址
+	Timeout time.Duration     `json:",default=5s"` // 单次推送超时
+	Headers map[string]string `json:",optional"`   // 附加的请求头，如鉴权令牌
+}
+
+// WatchConf 组织树变更订阅配置，订阅方从发件箱按事件 ID 顺序读取变更
+type WatchConf struct {
+	PollInterval time.Duration `json:",default=1s"`  // 轮询发件箱的间隔
+	BatchSize    int           `json:",default=500"` // 每次读取的事件数量，同时作为快照分片的组织数量
+	GapTimeout   time.Duration `json:",default=10s"` // 等待事件 ID 空洞（未提交的事务）填补的最长时间；超时仍未填补（事务回滚或运行过长）时要求订阅方重新同步
+}
+
+// WebhookDeliveryConf Webhook 订阅的投递配置；事件由发件箱投递器按订阅分发，需同时启用 Outbox
+type WebhookDeliveryConf struct {
+	Enabled     bool          `json:",default=true"` // 是否分发并投递订阅
+	Interval    time.Duration `json:",default=1s"`   // 轮询待投递记录的间隔
+	Concurrency int           `json:",default=10"`   // 同时投递的请求数量，也是每批领取的记录数量
+	Timeout     time.Duration `json:",default=10s"`  // 单次推送超时
+	MinBackoff  time.Duration `json:",default=10s"`  // 投递失败后首次重试的等待时间，之后逐次翻倍
+	MaxBackoff  time.Duration `json:",default=1h"`   // 重试等待时间的上限
+	MaxAttempts int64         `json:",default=10"`   // 最多尝试次数，耗尽后转为死信不再投递
 }
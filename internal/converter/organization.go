@@ -0,0 +1,133 @@
+// Package converter model 与 proto 之间的组织及组织变更事件转换，供 RPC 逻辑与发件箱投递、Webhook 分发等后台任务共用
+package converter
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// orgTypePrefix OrgType 枚举值名称的公共前缀
+const orgTypePrefix = "ORG_TYPE_"
+
+// changeTypePrefix ChangeType 枚举值名称的公共前缀
+const changeTypePrefix = "CHANGE_TYPE_"
+
+// ModelToProtoOrganization 将model组织转换为proto组织
+func ModelToProtoOrganization(source *model.Organizations) *organization.Organization {
+	return &organization.Organization{
+		Id:          source.Id,
+		ParentId:    source.ParentId.Int64,
+		Name:        source.Name,
+		CreatedAt:   source.CreatedAt.UnixMilli(),
+		UpdatedAt:   source.UpdatedAt.UnixMilli(),
+		DeletedAt:   nullTimeToMilli(source.DeletedAt),
+		DisabledAt:  nullTimeToMilli(source.DisabledAt),
+		Path:        source.Path,
+		OrgType:     OrgTypeToProto(source.OrgType),
+		Code:        source.Code,
+		Attributes:  AttributesToProto(source.Attributes),
+		SortOrder:   int32(source.SortOrder),
+		CreateTime:  timestamppb.New(source.CreatedAt),
+		UpdateTime:  timestamppb.New(source.UpdatedAt),
+		DeleteTime:  nullTimeToTimestamp(source.DeletedAt),
+		DisableTime: nullTimeToTimestamp(source.DisabledAt),
+	}
+}
+
+// ModelToProtoOrganizationTree 将model的组织树转换为proto的组织树
+func ModelToProtoOrganizationTree(source *model.OrganizationsTree) *organization.OrganizationTree {
+	if source == nil {
+		return nil
+	}
+
+	// 转换当前节点，复用单个组织转换函数
+	org := ModelToProtoOrganization(source.Organizations)
+	dst := &organization.OrganizationTree{
+		Id:          org.Id,
+		Name:        org.Name,
+		ParentId:    org.ParentId,
+		CreatedAt:   org.CreatedAt,
+		UpdatedAt:   org.UpdatedAt,
+		DeletedAt:   org.DeletedAt,
+		DisabledAt:  org.DisabledAt,
+		Path:        org.Path,
+		OrgType:     org.OrgType,
+		Code:        org.Code,
+		Attributes:  org.Attributes,
+		SortOrder:   org.SortOrder,
+		CreateTime:  org.CreateTime,
+		UpdateTime:  org.UpdateTime,
+		DeleteTime:  org.DeleteTime,
+		DisableTime: org.DisableTime,
+		Children:    make([]*organization.OrganizationTree, 0, len(source.Children)),
+	}
+
+	// 递归转换子节点
+	for _, child := range source.Children {
+		if childTree := ModelToProtoOrganizationTree(child); childTree != nil {
+			dst.Children = append(dst.Children, childTree)
+		}
+	}
+
+	return dst
+}
+
+// ModelToProtoOrganizationChanged 将发件箱记录转换为组织变更事件
+func ModelToProtoOrganizationChanged(source *model.Outbox) (*organization.OrganizationChanged, error) {
+	org, err := source.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &organization.OrganizationChanged{
+		EventId:          source.Id,
+		Type:             ChangeTypeToProto(source.EventType),
+		OrganizationId:   source.OrganizationId,
+		Organization:     ModelToProtoOrganization(org),
+		PreviousParentId: source.PreviousParentId.Int64,
+		OccurTime:        timestamppb.New(source.CreatedAt),
+	}, nil
+}
+
+// OrgTypeToProto 将库中的组织类型（如 department）转换为 proto 枚举，未知类型返回 ORG_TYPE_UNSPECIFIED
+func OrgTypeToProto(orgType string) organization.OrgType {
+	return organization.OrgType(organization.OrgType_value[orgTypePrefix+strings.ToUpper(orgType)])
+}
+
+// ChangeTypeToProto 将发件箱的变更类型（如 moved）转换为 proto 枚举，未知类型返回 CHANGE_TYPE_UNSPECIFIED
+func ChangeTypeToProto(changeType string) organization.ChangeType {
+	return organization.ChangeType(organization.ChangeType_value[changeTypePrefix+strings.ToUpper(changeType)])
+}
+
+// AttributesToProto 将库中的 JSON 扩展属性转换为 Struct，为空或解析失败时返回 nil
+func AttributesToProto(attributes string) *structpb.Struct {
+	if attributes == "" {
+		return nil
+	}
+	var s structpb.Struct
+	if err := protojson.Unmarshal([]byte(attributes), &s); err != nil {
+		return nil
+	}
+	return &s
+}
+
+// nullTimeToMilli 将可空时间转换为毫秒时间戳，NULL 返回 0
+func nullTimeToMilli(t sql.NullTime) int64 {
+	if !t.Valid {
+		return 0
+	}
+	return t.Time.UnixMilli()
+}
+
+// nullTimeToTimestamp 将可空时间转换为 Timestamp，NULL 返回 nil
+func nullTimeToTimestamp(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
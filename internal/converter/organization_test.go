@@ -0,0 +1,34 @@
+package converter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ziptako/organization/db/model"
+)
+
+func TestModelToProtoOrganizationMilliseconds(t *testing.T) {
+	created := time.Date(2024, 3, 1, 8, 30, 15, 123456789, time.UTC)
+	org := ModelToProtoOrganization(&model.Organizations{
+		Id:        1,
+		CreatedAt: created,
+		UpdatedAt: created.Add(time.Second),
+	})
+
+	if org.CreatedAt != created.UnixMilli() {
+		t.Fatalf("CreatedAt = %d, want %d", org.CreatedAt, created.UnixMilli())
+	}
+	if org.UpdatedAt != created.Add(time.Second).UnixMilli() {
+		t.Fatalf("UpdatedAt = %d, want %d", org.UpdatedAt, created.Add(time.Second).UnixMilli())
+	}
+	if !org.CreateTime.AsTime().Equal(created) {
+		t.Fatalf("CreateTime = %v, want %v", org.CreateTime.AsTime(), created)
+	}
+	// NULL 的删除/禁用时间：毫秒字段为 0，Timestamp 字段为空
+	if org.DeletedAt != 0 || org.DeleteTime != nil {
+		t.Fatalf("deleted: got %d / %v, want 0 / nil", org.DeletedAt, org.DeleteTime)
+	}
+	if org.DisabledAt != 0 || org.DisableTime != nil {
+		t.Fatalf("disabled: got %d / %v, want 0 / nil", org.DisabledAt, org.DisableTime)
+	}
+}
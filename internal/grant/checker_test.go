@@ -0,0 +1,152 @@
+package grant
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/auth"
+)
+
+var testNow = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// fakeGrants 内存中的授权，组织树为 1 -> 2 -> 3
+type fakeGrants struct {
+	model.AdminGrantsModel
+	grants []*model.AdminGrants
+}
+
+// ancestors 组织自身及其祖先，下标为层级距离
+var ancestors = map[int64][]int64{1: {1}, 2: {2, 1}, 3: {3, 2, 1}}
+
+func (f *fakeGrants) FindScopes(_ context.Context, _ sqlx.Session, userId, organizationId int64) ([]*model.AdminGrantScope, error) {
+	var scopes []*model.AdminGrantScope
+	for depth, ancestorId := range ancestors[organizationId] {
+		for _, g := range f.grants {
+			if g.UserId == userId && g.OrganizationId == ancestorId {
+				scopes = append(scopes, &model.AdminGrantScope{
+					Id:          g.Id,
+					Permissions: g.Permissions,
+					Inherit:     g.Inherit,
+					ExpiresAt:   g.ExpiresAt,
+					Depth:       int64(depth),
+				})
+			}
+		}
+	}
+	return scopes, nil
+}
+
+// revoke 撤销授权
+func (f *fakeGrants) revoke(id int64) {
+	f.grants = slices.DeleteFunc(f.grants, func(g *model.AdminGrants) bool { return g.Id == id })
+}
+
+func newTestChecker(grants ...*model.AdminGrants) (*Checker, *fakeGrants) {
+	f := &fakeGrants{grants: grants}
+	c := NewChecker(f, true)
+	c.now = func() time.Time { return testNow }
+	return c, f
+}
+
+func scopedContext(subject string) context.Context {
+	return auth.NewContext(context.Background(), &auth.Principal{Subject: subject, Scoped: true})
+}
+
+func TestCheckerCheck(t *testing.T) {
+	expired := sql.NullTime{Valid: true, Time: testNow.Add(-time.Minute)}
+	future := sql.NullTime{Valid: true, Time: testNow.Add(time.Hour)}
+	tests := []struct {
+		name    string
+		grant   *model.AdminGrants
+		subtree bool
+		orgId   int64
+		want    error
+	}{
+		{name: "own grant", grant: &model.AdminGrants{OrganizationId: 2, Permissions: []string{PermUpdate}}, orgId: 2},
+		{name: "own grant without inherit on descendant", grant: &model.AdminGrants{OrganizationId: 2, Permissions: []string{PermUpdate}}, orgId: 3, want: ErrNotGranted},
+		{name: "own grant without inherit on subtree", grant: &model.AdminGrants{OrganizationId: 2, Permissions: []string{PermUpdate}}, subtree: true, orgId: 2, want: ErrNotGranted},
+		{name: "ancestor grant", grant: &model.AdminGrants{OrganizationId: 1, Permissions: []string{PermUpdate}, Inherit: true}, orgId: 3},
+		{name: "ancestor grant on subtree", grant: &model.AdminGrants{OrganizationId: 1, Permissions: []string{PermUpdate}, Inherit: true}, subtree: true, orgId: 2},
+		{name: "ancestor grant without inherit", grant: &model.AdminGrants{OrganizationId: 1, Permissions: []string{PermUpdate}}, orgId: 2, want: ErrNotGranted},
+		{name: "grant on descendant", grant: &model.AdminGrants{OrganizationId: 3, Permissions: []string{PermUpdate}, Inherit: true}, orgId: 2, want: ErrNotGranted},
+		{name: "missing permission", grant: &model.AdminGrants{OrganizationId: 1, Permissions: []string{PermCreate}, Inherit: true}, orgId: 2, want: ErrNotGranted},
+		{name: "expired", grant: &model.AdminGrants{OrganizationId: 1, Permissions: []string{PermUpdate}, Inherit: true, ExpiresAt: expired}, orgId: 2, want: ErrNotGranted},
+		{name: "not yet expired", grant: &model.AdminGrants{OrganizationId: 1, Permissions: []string{PermUpdate}, Inherit: true, ExpiresAt: future}, orgId: 2},
+		{name: "other user", grant: &model.AdminGrants{UserId: 8, OrganizationId: 1, Permissions: []string{PermUpdate}, Inherit: true}, orgId: 2, want: ErrNotGranted},
+		{name: "root level", grant: &model.AdminGrants{OrganizationId: 1, Permissions: []string{PermUpdate}, Inherit: true}, orgId: 0, want: errRootLevel},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.grant.UserId == 0 {
+				tt.grant.UserId = 7
+			}
+			c, _ := newTestChecker(tt.grant)
+			check := c.Check
+			if tt.subtree {
+				check = c.CheckSubtree
+			}
+			if _, err := check(scopedContext("7"), PermUpdate, tt.orgId); !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckerCaller(t *testing.T) {
+	c, _ := newTestChecker()
+	tests := []struct {
+		name    string
+		checker *Checker
+		ctx     context.Context
+		want    error
+	}{
+		{name: "auth disabled", checker: NewChecker(&fakeGrants{}, false), ctx: context.Background()},
+		{name: "global role", checker: c, ctx: auth.NewContext(context.Background(), &auth.Principal{Subject: "7"})},
+		{name: "no caller", checker: c, ctx: context.Background(), want: ErrNotGranted},
+		{name: "invalid subject", checker: c, ctx: scopedContext("alice"), want: ErrNotGranted},
+		{name: "no grant", checker: c, ctx: scopedContext("7"), want: ErrNotGranted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.checker.Check(tt.ctx, PermUpdate, 2); !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckerGuard(t *testing.T) {
+	grant := func() *model.AdminGrants {
+		return &model.AdminGrants{Id: 1, UserId: 7, OrganizationId: 1, Permissions: []string{PermUpdate}, Inherit: true, ExpiresAt: sql.NullTime{Valid: true, Time: testNow.Add(time.Hour)}}
+	}
+	tests := []struct {
+		name   string
+		change func(c *Checker, f *fakeGrants)
+		want   error
+	}{
+		{name: "unchanged", change: func(*Checker, *fakeGrants) {}},
+		{name: "revoked", change: func(_ *Checker, f *fakeGrants) { f.revoke(1) }, want: ErrNotGranted},
+		{name: "permission removed", change: func(_ *Checker, f *fakeGrants) { f.grants[0].Permissions = []string{PermCreate} }, want: ErrNotGranted},
+		{name: "expired", change: func(c *Checker, _ *fakeGrants) { c.now = func() time.Time { return testNow.Add(2 * time.Hour) } }, want: ErrNotGranted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, f := newTestChecker(grant())
+			ctx, err := c.Check(scopedContext("7"), PermUpdate, 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 检查之后、变更事务开始之前授权发生变化，事务中的复核以变化后的授权为准
+			tt.change(c, f)
+			if err := model.RunGuards(ctx, nil); !errors.Is(err, tt.want) {
+				t.Fatalf("guard err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package hierarchy
+
+import "github.com/ziptako/organization/internal/config"
+
+// Schema 组织类型层级规则，判断某类型的组织能否挂在另一类型的组织下
+type Schema struct {
+	defaultType string
+	roots       map[string]struct{}
+	parents     map[string]map[string]struct{}
+}
+
+// NewSchema 根据配置创建层级规则
+func NewSchema(c config.OrgTypeConf) *Schema {
+	s := &Schema{
+		defaultType: c.Default,
+		roots:       make(map[string]struct{}, len(c.RootTypes)),
+		parents:     make(map[string]map[string]struct{}, len(c.AllowedParents)),
+	}
+	for _, t := range c.RootTypes {
+		s.roots[t] = struct{}{}
+	}
+	for child, parents := range c.AllowedParents {
+		set := make(map[string]struct{}, len(parents))
+		for _, parent := range parents {
+			set[parent] = struct{}{}
+		}
+		s.parents[child] = set
+	}
+	return s
+}
+
+// DefaultType 未指定类型时使用的组织类型
+func (s *Schema) DefaultType() string {
+	return s.defaultType
+}
+
+// Allowed 判断 childType 类型的组织能否挂在 parentType 类型的组织下，parentType 为空表示作为根节点
+func (s *Schema) Allowed(parentType, childType string) bool {
+	if len(s.roots) == 0 && len(s.parents) == 0 {
+		return true
+	}
+	if parentType == "" {
+		_, ok := s.roots[childType]
+		return ok
+	}
+	_, ok := s.parents[childType][parentType]
+	return ok
+}
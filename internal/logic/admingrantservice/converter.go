@@ -0,0 +1,43 @@
+package admingrantservicelogic
+
+import (
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+)
+
+// adminPermissionPrefix AdminPermission 枚举值名称的公共前缀
+const adminPermissionPrefix = "ADMIN_PERMISSION_"
+
+// ModelToProtoAdminGrant 将model授权转换为proto授权
+func ModelToProtoAdminGrant(source *model.AdminGrants) *organization.AdminGrant {
+	permissions := make([]organization.AdminPermission, 0, len(source.Permissions))
+	for _, permission := range source.Permissions {
+		permissions = append(permissions, organization.AdminPermission(organization.AdminPermission_value[adminPermissionPrefix+strings.ToUpper(permission)]))
+	}
+	grant := &organization.AdminGrant{
+		Id:             source.Id,
+		UserId:         source.UserId,
+		OrganizationId: source.OrganizationId,
+		Permissions:    permissions,
+		Inherit:        source.Inherit,
+		CreateTime:     timestamppb.New(source.CreatedAt),
+		UpdateTime:     timestamppb.New(source.UpdatedAt),
+	}
+	if source.ExpiresAt.Valid {
+		grant.ExpireTime = timestamppb.New(source.ExpiresAt.Time)
+	}
+	return grant
+}
+
+// adminPermissionFromProto 将 proto 枚举转换为库中的权限名，ADMIN_PERMISSION_UNSPECIFIED 及未知取值返回空字符串
+func adminPermissionFromProto(permission organization.AdminPermission) string {
+	if permission == organization.AdminPermission_ADMIN_PERMISSION_UNSPECIFIED {
+		return ""
+	}
+	if _, ok := organization.AdminPermission_name[int32(permission)]; !ok {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(permission.String(), adminPermissionPrefix))
+}
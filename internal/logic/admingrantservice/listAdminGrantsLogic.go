@@ -0,0 +1,49 @@
+package admingrantservicelogic
+
+import (
+	"context"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListAdminGrantsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.AdminGrantsModel
+}
+
+func NewListAdminGrantsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAdminGrantsLogic {
+	return &ListAdminGrantsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewAdminGrantsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// ListAdminGrants 查询组织管理授权
+func (l *ListAdminGrantsLogic) ListAdminGrants(in *organization.ListAdminGrantsRequest) (*organization.ListAdminGrantsResponse, error) {
+	if in.UserId == 0 && in.OrganizationId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "[LG001] 用户与组织至少指定一个")
+	}
+	grants, err := l.model.FindByFilter(l.ctx, in.UserId, in.OrganizationId)
+	if err != nil {
+		eInfo := "[LG002] 查询管理授权失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	items := make([]*organization.AdminGrant, 0, len(grants))
+	for _, grant := range grants {
+		items = append(items, ModelToProtoAdminGrant(grant))
+	}
+	return &organization.ListAdminGrantsResponse{
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package admingrantservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeAdminLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.AdminGrantsModel
+}
+
+func NewRevokeAdminLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeAdminLogic {
+	return &RevokeAdminLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewAdminGrantsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// RevokeAdmin 撤销组织管理授权
+func (l *RevokeAdminLogic) RevokeAdmin(in *organization.RevokeAdminRequest) (*organization.RevokeAdminResponse, error) {
+	if _, err := l.model.FindOne(l.ctx, in.Id); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[RA001] 管理授权不存在")
+		}
+		eInfo := "[RA002] 查询管理授权失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if err := l.model.Delete(l.ctx, in.Id); err != nil {
+		eInfo := "[RA003] 撤销管理授权失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return &organization.RevokeAdminResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package auditservicelogic
+
+import (
+	"database/sql"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ModelToProtoAuditLogEntry 将model审计日志转换为proto审计日志
+func ModelToProtoAuditLogEntry(source *model.AuditLog) *organization.AuditLogEntry {
+	return &organization.AuditLogEntry{
+		Id:             source.Id,
+		Actor:          source.Actor,
+		Action:         source.Action,
+		OrganizationId: source.OrganizationId,
+		Before:         dataToProto(source.BeforeData),
+		After:          dataToProto(source.AfterData),
+		RequestId:      source.RequestId,
+		CreateTime:     timestamppb.New(source.CreatedAt),
+		Entity:         source.Entity,
+		EntityId:       source.EntityId,
+	}
+}
+
+// dataToProto 将 JSON 格式的记录数据转换为 Struct，为 NULL 或无法解析时返回 nil
+func dataToProto(data sql.NullString) *structpb.Struct {
+	if !data.Valid {
+		return nil
+	}
+	var s structpb.Struct
+	if err := protojson.Unmarshal([]byte(data.String), &s); err != nil {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,85 @@
+package auditservicelogic
+
+import (
+	"context"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 审计日志分页大小
+const (
+	defaultAuditLogLimit = 20
+	maxAuditLogLimit     = 500
+)
+
+type ListAuditLogLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.AuditLogModel
+}
+
+func NewListAuditLogLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAuditLogLogic {
+	return &ListAuditLogLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewAuditLogModel(svcCtx.SqlConn),
+	}
+}
+
+// ListAuditLog 按组织、子树、操作人、接口与时间范围查询审计日志，按时间倒序
+// 组织可能已被物理删除，因此不检查组织是否存在
+func (l *ListAuditLogLogic) ListAuditLog(in *organization.ListAuditLogRequest) (*organization.ListAuditLogResponse, error) {
+	if in.OrganizationId < 0 || in.BeforeId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "[LL001] 组织 ID 与 before_id 不能为负数")
+	}
+	if in.IncludeDescendants && in.OrganizationId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "[LL002] 查询子树时需指定组织")
+	}
+	filter := &model.AuditLogFilter{
+		OrganizationId:     in.OrganizationId,
+		IncludeDescendants: in.IncludeDescendants,
+		Actor:              in.Actor,
+		Action:             in.Action,
+		BeforeId:           in.BeforeId,
+	}
+	if in.StartTime != nil {
+		filter.StartTime = in.StartTime.AsTime()
+	}
+	if in.EndTime != nil {
+		filter.EndTime = in.EndTime.AsTime()
+	}
+	if !filter.StartTime.IsZero() && !filter.EndTime.IsZero() && !filter.StartTime.Before(filter.EndTime) {
+		return nil, status.Error(codes.InvalidArgument, "[LL003] 起始时间需早于截止时间")
+	}
+	limit := int64(in.Limit)
+	if limit <= 0 {
+		limit = defaultAuditLogLimit
+	}
+	limit = min(limit, maxAuditLogLimit)
+
+	entries, err := l.model.FindPage(l.ctx, filter, limit)
+	if err != nil {
+		eInfo := "[LL004] 查询审计日志失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	resp := &organization.ListAuditLogResponse{
+		Items: make([]*organization.AuditLogEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Items = append(resp.Items, ModelToProtoAuditLogEntry(entry))
+	}
+	// 满页时可能还有更早的记录
+	if int64(len(entries)) == limit {
+		resp.NextBeforeId = entries[len(entries)-1].Id
+	}
+	return resp, nil
+}
@@ -0,0 +1,65 @@
+package membershipservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AddMemberLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.MembershipsModel
+}
+
+func NewAddMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddMemberLogic {
+	return &AddMemberLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewMembershipsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// AddMember 添加组织成员
+func (l *AddMemberLogic) AddMember(in *organization.AddMemberRequest) (*organization.Membership, error) {
+	if in.UserId <= 0 {
+		return nil, errInvalidUser
+	}
+	now := time.Now()
+	joinedAt := now
+	if in.JoinTime != nil {
+		joinedAt = in.JoinTime.AsTime()
+		if joinedAt.After(now) {
+			return nil, status.Error(codes.InvalidArgument, "[AM001] 加入时间不能晚于当前时间")
+		}
+	}
+
+	membership := &model.Memberships{
+		OrganizationId: in.OrganizationId,
+		UserId:         in.UserId,
+		IsPrimary:      in.IsPrimary,
+		JoinedAt:       joinedAt,
+	}
+	err := l.model.AddMember(l.ctx, membership)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "[AM002] 组织节点不存在")
+		case errors.Is(err, model.ErrAlreadyMember):
+			return nil, status.Error(codes.AlreadyExists, "[AM003] 用户已是该组织成员")
+		}
+		eInfo := "[AM004] 添加组织成员失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return ModelToProtoMembership(membership), nil
+}
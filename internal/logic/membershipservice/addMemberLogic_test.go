@@ -0,0 +1,52 @@
+package membershipservicelogic
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeMemberships AddMember 返回指定的错误
+type fakeMemberships struct {
+	model.MembershipsModel
+	err error
+}
+
+func (f *fakeMemberships) AddMember(_ context.Context, data *model.Memberships) error {
+	if f.err == nil {
+		data.Id = 1
+	}
+	return f.err
+}
+
+func TestAddMemberErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{name: "added", want: codes.OK},
+		{name: "organization not found", err: model.ErrNotFound, want: codes.NotFound},
+		{name: "already member", err: model.ErrAlreadyMember, want: codes.AlreadyExists},
+		{name: "database error", err: errors.New("connection reset"), want: codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			l := &AddMemberLogic{ctx: ctx, Logger: logx.WithContext(ctx), model: &fakeMemberships{err: tt.err}}
+			resp, err := l.AddMember(&organization.AddMemberRequest{OrganizationId: 2, UserId: 7, IsPrimary: true})
+			if code := status.Code(err); code != tt.want {
+				t.Fatalf("code = %v (%v), want %v", code, err, tt.want)
+			}
+			if err == nil && (resp.Id != 1 || !resp.IsPrimary) {
+				t.Fatalf("resp = %+v, want id 1 and primary", resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package membershipservicelogic
+
+import (
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ModelToProtoMembership 将model成员关系转换为proto成员关系
+func ModelToProtoMembership(source *model.Memberships) *organization.Membership {
+	dst := &organization.Membership{
+		Id:             source.Id,
+		OrganizationId: source.OrganizationId,
+		UserId:         source.UserId,
+		IsPrimary:      source.IsPrimary,
+		JoinTime:       timestamppb.New(source.JoinedAt),
+	}
+	if source.LeftAt.Valid {
+		dst.LeaveTime = timestamppb.New(source.LeftAt.Time)
+	}
+	return dst
+}
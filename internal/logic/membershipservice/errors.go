@@ -0,0 +1,11 @@
+package membershipservicelogic
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 多个接口共用的错误，错误码保持稳定供调用方判断
+var (
+	errInvalidUser = status.Error(codes.InvalidArgument, "[MS001] 用户 ID 不合法")
+)
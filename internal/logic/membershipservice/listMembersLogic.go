@@ -0,0 +1,82 @@
+package membershipservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	defaultListLimit = 20  // 默认分页大小
+	maxListLimit     = 500 // 最大分页大小
+)
+
+type ListMembersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model    model.MembershipsModel
+	orgModel model.OrganizationsModel
+}
+
+func NewListMembersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListMembersLogic {
+	return &ListMembersLogic{
+		ctx:      ctx,
+		svcCtx:   svcCtx,
+		Logger:   logx.WithContext(ctx),
+		model:    model.NewMembershipsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+		orgModel: model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// ListMembers 分页查询组织成员
+func (l *ListMembersLogic) ListMembers(in *organization.ListMembersRequest) (*organization.ListMembersResponse, error) {
+	if in.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "[LM001] 偏移量不能为负数")
+	}
+	limit := in.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	// 检查组织
+	if _, err := l.orgModel.FindOne(l.ctx, in.OrganizationId); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[LM002] 组织节点不存在")
+		}
+		eInfo := "[LM003] 查询组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	total, err := l.model.CountMembers(l.ctx, in.OrganizationId, in.IncludeSubtree)
+	if err != nil {
+		eInfo := "[LM004] 统计组织成员失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	members, err := l.model.FindMembers(l.ctx, in.OrganizationId, in.IncludeSubtree, int64(limit), int64(in.Offset))
+	if err != nil {
+		eInfo := "[LM005] 查询组织成员失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	items := make([]*organization.Membership, 0, len(members))
+	for _, member := range members {
+		items = append(items, ModelToProtoMembership(member))
+	}
+	return &organization.ListMembersResponse{
+		Items: items,
+		Total: int32(total),
+	}, nil
+}
@@ -0,0 +1,68 @@
+package membershipservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOrganizationsOfUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model    model.MembershipsModel
+	orgModel model.OrganizationsModel
+}
+
+func NewListOrganizationsOfUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOrganizationsOfUserLogic {
+	return &ListOrganizationsOfUserLogic{
+		ctx:      ctx,
+		svcCtx:   svcCtx,
+		Logger:   logx.WithContext(ctx),
+		model:    model.NewMembershipsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+		orgModel: model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// ListOrganizationsOfUser 查询用户所属的组织
+func (l *ListOrganizationsOfUserLogic) ListOrganizationsOfUser(in *organization.ListOrganizationsOfUserRequest) (*organization.ListOrganizationsOfUserResponse, error) {
+	if in.UserId <= 0 {
+		return nil, errInvalidUser
+	}
+	memberships, err := l.model.FindByUserId(l.ctx, in.UserId, in.IncludeLeft)
+	if err != nil {
+		eInfo := "[LU001] 查询用户组织归属失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 用户所属组织通常很少，逐个读取组织缓存
+	items := make([]*organization.UserOrganization, 0, len(memberships))
+	for _, membership := range memberships {
+		item := &organization.UserOrganization{
+			Membership: ModelToProtoMembership(membership),
+		}
+		org, err := l.orgModel.FindOne(l.ctx, membership.OrganizationId)
+		switch {
+		case err == nil:
+			item.Organization = converter.ModelToProtoOrganization(org)
+		case errors.Is(err, model.ErrNotFound):
+			// 组织已删除，仅历史归属会出现这种情况
+		default:
+			eInfo := "[LU002] 查询组织节点失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		items = append(items, item)
+	}
+	return &organization.ListOrganizationsOfUserResponse{
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package membershipservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RemoveMemberLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.MembershipsModel
+}
+
+func NewRemoveMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveMemberLogic {
+	return &RemoveMemberLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewMembershipsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// RemoveMember 移除组织成员
+func (l *RemoveMemberLogic) RemoveMember(in *organization.RemoveMemberRequest) (*organization.RemoveMemberResponse, error) {
+	err := l.model.RemoveMember(l.ctx, in.OrganizationId, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[RM001] 用户不是该组织成员")
+		}
+		eInfo := "[RM002] 移除组织成员失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return &organization.RemoveMemberResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package organizationservicelogic
+
+import (
+	"context"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxBatchCodes 单次批量查询的最大编码数量
+const maxBatchCodes = 500
+
+type BatchGetOrganizationsByCodeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.OrganizationsModel
+}
+
+func NewBatchGetOrganizationsByCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchGetOrganizationsByCodeLogic {
+	return &BatchGetOrganizationsByCodeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// BatchGetOrganizationsByCode 按组织编码批量获取组织节点
+func (l *BatchGetOrganizationsByCodeLogic) BatchGetOrganizationsByCode(in *organization.BatchGetOrganizationsByCodeRequest) (*organization.BatchGetOrganizationsByCodeResponse, error) {
+	// 去重并保持首次出现的顺序
+	codeList := make([]string, 0, len(in.Codes))
+	seen := make(map[string]struct{}, len(in.Codes))
+	for _, code := range in.Codes {
+		if _, ok := seen[code]; ok || code == "" {
+			continue
+		}
+		seen[code] = struct{}{}
+		codeList = append(codeList, code)
+	}
+	if len(codeList) > maxBatchCodes {
+		return nil, status.Errorf(codes.InvalidArgument, "[BC001] 单次最多查询 %d 个编码", maxBatchCodes)
+	}
+	if len(codeList) == 0 {
+		return &organization.BatchGetOrganizationsByCodeResponse{}, nil
+	}
+
+	organizations, err := l.model.FindByCodes(l.ctx, codeList)
+	if err != nil {
+		eInfo := "[BC002] 批量获取组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	found := make(map[string]*model.Organizations, len(organizations))
+	for _, org := range organizations {
+		found[org.Code] = org
+	}
+	resp := &organization.BatchGetOrganizationsByCodeResponse{
+		Items: make([]*organization.Organization, 0, len(organizations)),
+	}
+	for _, code := range codeList {
+		if org, ok := found[code]; ok {
+			resp.Items = append(resp.Items, converter.ModelToProtoOrganization(org))
+		} else {
+			resp.MissingCodes = append(resp.MissingCodes, code)
+		}
+	}
+	return resp, nil
+}
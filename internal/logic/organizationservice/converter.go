@@ -4,65 +4,18 @@ import (
 	"database/sql"
 	"github.com/ziptako/organization/db/model"
 	"github.com/ziptako/organization/organization"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
 	"time"
 )
 
-// ModelToProtoOrganization 将model组织转换为proto组织
-func ModelToProtoOrganization(source *model.Organizations) *organization.Organization {
-	res := &organization.Organization{
-		Id:        source.Id,
-		ParentId:  source.ParentId.Int64,
-		Name:      source.Name,
-		CreatedAt: source.CreatedAt.Unix(),
-		UpdatedAt: source.UpdatedAt.Unix(),
-	}
-	if source.DeletedAt.Valid {
-		res.DeletedAt = source.DeletedAt.Time.Unix()
-	}
-	if source.DisabledAt.Valid {
-		res.DisabledAt = source.DisabledAt.Time.Unix()
-	}
-	return res
-}
-
-// ModelToProtoOrganizationTree 将model的组织树转换为proto的组织树
-func ModelToProtoOrganizationTree(source *model.OrganizationsTree) *organization.OrganizationTree {
-	if source == nil {
-		return nil
-	}
-
-	// 转换当前节点
-	dst := &organization.OrganizationTree{
-		Id:        source.Organizations.Id,
-		Name:      source.Organizations.Name,
-		ParentId:  source.Organizations.ParentId.Int64,
-		CreatedAt: source.Organizations.CreatedAt.Unix(),
-		UpdatedAt: source.Organizations.UpdatedAt.Unix(),
-		DeletedAt: 0,
-		Children:  make([]*organization.OrganizationTree, 0, len(source.Children)),
-	}
-
-	// 处理删除时间
-	if source.Organizations.DeletedAt.Valid {
-		dst.DeletedAt = source.Organizations.DeletedAt.Time.Unix()
-	}
-
-	// 处理禁用时间
-	if source.Organizations.DisabledAt.Valid {
-		dst.DisabledAt = source.Organizations.DisabledAt.Time.Unix()
-	}
-
-	// 递归转换子节点
-	for _, child := range source.Children {
-		if childTree := ModelToProtoOrganizationTree(child); childTree != nil {
-			dst.Children = append(dst.Children, childTree)
-		}
-	}
-
-	return dst
-}
+// orgTypePrefix OrgType 枚举值名称的公共前缀
+const orgTypePrefix = "ORG_TYPE_"
 
 // ProtoToModelOrganization 将proto组织转换为model组织
+// 优先使用 Timestamp 字段，未设置时回退到毫秒字段
 func ProtoToModelOrganization(source *organization.Organization) *model.Organizations {
 	return &model.Organizations{
 		Id: source.Id,
@@ -70,17 +23,16 @@ func ProtoToModelOrganization(source *organization.Organization) *model.Organiza
 			Valid: source.ParentId != 0,
 			Int64: source.ParentId,
 		},
-		Name:      source.Name,
-		CreatedAt: time.Unix(source.CreatedAt, 0),
-		UpdatedAt: time.Unix(source.UpdatedAt, 0),
-		DeletedAt: sql.NullTime{
-			Valid: source.DeletedAt != 0,
-			Time:  time.Unix(source.DeletedAt, 0),
-		},
-		DisabledAt: sql.NullTime{
-			Valid: source.DisabledAt != 0,
-			Time:  time.Unix(source.DisabledAt, 0),
-		},
+		Name:       source.Name,
+		CreatedAt:  protoTime(source.CreateTime, source.CreatedAt).Time,
+		UpdatedAt:  protoTime(source.UpdateTime, source.UpdatedAt).Time,
+		DeletedAt:  protoTime(source.DeleteTime, source.DeletedAt),
+		DisabledAt: protoTime(source.DisableTime, source.DisabledAt),
+		Path:       source.Path,
+		OrgType:    orgTypeFromProto(source.OrgType),
+		Code:       source.Code,
+		Attributes: attributesFromProto(source.Attributes),
+		SortOrder:  int64(source.SortOrder),
 	}
 }
 
@@ -92,13 +44,22 @@ func ProtoToModelOrganizationTree(source *organization.OrganizationTree) *model.
 
 	// 转换当前节点，复用单个组织转换函数
 	modelOrg := ProtoToModelOrganization(&organization.Organization{
-		Id:         source.Id,
-		ParentId:   source.ParentId,
-		Name:       source.Name,
-		CreatedAt:  source.CreatedAt,
-		UpdatedAt:  source.UpdatedAt,
-		DeletedAt:  source.DeletedAt,
-		DisabledAt: source.DisabledAt,
+		Id:          source.Id,
+		ParentId:    source.ParentId,
+		Name:        source.Name,
+		CreatedAt:   source.CreatedAt,
+		UpdatedAt:   source.UpdatedAt,
+		DeletedAt:   source.DeletedAt,
+		DisabledAt:  source.DisabledAt,
+		Path:        source.Path,
+		OrgType:     source.OrgType,
+		Code:        source.Code,
+		Attributes:  source.Attributes,
+		SortOrder:   source.SortOrder,
+		CreateTime:  source.CreateTime,
+		UpdateTime:  source.UpdateTime,
+		DeleteTime:  source.DeleteTime,
+		DisableTime: source.DisableTime,
 	})
 
 	// 创建组织树节点
@@ -116,3 +77,49 @@ func ProtoToModelOrganizationTree(source *organization.OrganizationTree) *model.
 
 	return tree
 }
+
+// orgTypeFromProto 将 proto 枚举转换为库中的组织类型，ORG_TYPE_UNSPECIFIED 及未知取值返回空字符串
+func orgTypeFromProto(orgType organization.OrgType) string {
+	if orgType == organization.OrgType_ORG_TYPE_UNSPECIFIED || !validOrgType(orgType) {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(orgType.String(), orgTypePrefix))
+}
+
+// validOrgType 判断是否为 proto 中定义的组织类型，客户端可能传入未定义的枚举值
+func validOrgType(orgType organization.OrgType) bool {
+	_, ok := organization.OrgType_name[int32(orgType)]
+	return ok
+}
+
+// attributesFromProto 将 Struct 转换为 JSON 扩展属性，未设置时返回空对象
+func attributesFromProto(attributes *structpb.Struct) string {
+	if attributes == nil {
+		return "{}"
+	}
+	data, err := protojson.Marshal(attributes)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
+// placement 将请求中的 before_id、after_id 转换为兄弟节点中的位置
+func placement(beforeId, afterId int64) (model.Placement, error) {
+	if beforeId != 0 && afterId != 0 {
+		return model.Placement{}, errPlacementConflict
+	}
+	return model.Placement{BeforeId: beforeId, AfterId: afterId}, nil
+}
+
+// protoTime 从 Timestamp 或毫秒时间戳还原时间，两者均未设置时返回 NULL
+func protoTime(ts *timestamppb.Timestamp, milli int64) sql.NullTime {
+	switch {
+	case ts != nil:
+		return sql.NullTime{Valid: true, Time: ts.AsTime()}
+	case milli != 0:
+		return sql.NullTime{Valid: true, Time: time.UnixMilli(milli)}
+	default:
+		return sql.NullTime{}
+	}
+}
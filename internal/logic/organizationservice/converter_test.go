@@ -0,0 +1,130 @@
+package organizationservicelogic
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
+	"github.com/ziptako/organization/organization"
+)
+
+func TestOrganizationRoundTrip(t *testing.T) {
+	created := time.Date(2024, 3, 1, 8, 30, 15, 123456789, time.UTC)
+	tests := []struct {
+		name     string
+		deleted  sql.NullTime
+		disabled sql.NullTime
+	}{
+		{name: "active"},
+		{name: "deleted", deleted: sql.NullTime{Valid: true, Time: created.Add(time.Hour)}},
+		{name: "disabled", disabled: sql.NullTime{Valid: true, Time: created.Add(time.Minute)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &model.Organizations{
+				Id:         7,
+				ParentId:   sql.NullInt64{Valid: true, Int64: 3},
+				Name:       "研发部",
+				CreatedAt:  created,
+				UpdatedAt:  created.Add(2 * time.Hour),
+				DeletedAt:  tt.deleted,
+				DisabledAt: tt.disabled,
+				Path:       "/3/7/",
+			}
+			got := ProtoToModelOrganization(converter.ModelToProtoOrganization(source))
+
+			if got.Id != source.Id || got.ParentId != source.ParentId || got.Name != source.Name || got.Path != source.Path {
+				t.Fatalf("fields mismatch: got %+v, want %+v", got, source)
+			}
+			if !got.CreatedAt.Equal(source.CreatedAt) || !got.UpdatedAt.Equal(source.UpdatedAt) {
+				t.Fatalf("timestamps mismatch: got %v/%v, want %v/%v", got.CreatedAt, got.UpdatedAt, source.CreatedAt, source.UpdatedAt)
+			}
+			assertNullTime(t, "DeletedAt", got.DeletedAt, source.DeletedAt)
+			assertNullTime(t, "DisabledAt", got.DisabledAt, source.DisabledAt)
+		})
+	}
+}
+
+func TestProtoToModelOrganizationLegacyMilliseconds(t *testing.T) {
+	created := time.Date(2024, 3, 1, 8, 30, 15, 123000000, time.UTC)
+	deleted := created.Add(time.Hour)
+	got := ProtoToModelOrganization(&organization.Organization{
+		Id:        1,
+		CreatedAt: created.UnixMilli(),
+		UpdatedAt: created.UnixMilli(),
+		DeletedAt: deleted.UnixMilli(),
+	})
+
+	if !got.CreatedAt.Equal(created) {
+		t.Fatalf("CreatedAt = %v, want %v", got.CreatedAt, created)
+	}
+	assertNullTime(t, "DeletedAt", got.DeletedAt, sql.NullTime{Valid: true, Time: deleted})
+	assertNullTime(t, "DisabledAt", got.DisabledAt, sql.NullTime{})
+	if got.ParentId.Valid {
+		t.Fatalf("ParentId should be NULL for parent_id 0")
+	}
+}
+
+func TestOrganizationTreeRoundTrip(t *testing.T) {
+	created := time.Date(2024, 3, 1, 8, 30, 15, 0, time.UTC)
+	disabled := sql.NullTime{Valid: true, Time: created.Add(time.Minute)}
+	source := &model.OrganizationsTree{
+		Organizations: &model.Organizations{Id: 1, Name: "集团", CreatedAt: created, UpdatedAt: created, Path: "/1/"},
+		Children: []*model.OrganizationsTree{{
+			Organizations: &model.Organizations{
+				Id:         2,
+				ParentId:   sql.NullInt64{Valid: true, Int64: 1},
+				Name:       "研发部",
+				CreatedAt:  created,
+				UpdatedAt:  created,
+				DisabledAt: disabled,
+				Path:       "/1/2/",
+			},
+		}},
+	}
+
+	tree := converter.ModelToProtoOrganizationTree(source)
+	if tree.CreatedAt != created.UnixMilli() || len(tree.Children) != 1 {
+		t.Fatalf("unexpected proto tree: %+v", tree)
+	}
+	if tree.Children[0].DisabledAt != disabled.Time.UnixMilli() || tree.Children[0].DisableTime == nil {
+		t.Fatalf("child disabled time not converted: %+v", tree.Children[0])
+	}
+
+	got := ProtoToModelOrganizationTree(tree)
+	if got.Id != 1 || len(got.Children) != 1 || got.Children[0].Id != 2 {
+		t.Fatalf("unexpected model tree: %+v", got)
+	}
+	assertNullTime(t, "root DisabledAt", got.DisabledAt, sql.NullTime{})
+	assertNullTime(t, "child DisabledAt", got.Children[0].DisabledAt, disabled)
+}
+
+func TestOrgTypeFromProto(t *testing.T) {
+	tests := []struct {
+		orgType organization.OrgType
+		want    string
+		valid   bool
+	}{
+		{organization.OrgType_ORG_TYPE_UNSPECIFIED, "", true},
+		{organization.OrgType_ORG_TYPE_DEPARTMENT, "department", true},
+		{organization.OrgType_ORG_TYPE_TEAM, "team", true},
+		{organization.OrgType(99), "", false},
+	}
+	for _, tt := range tests {
+		if got := orgTypeFromProto(tt.orgType); got != tt.want {
+			t.Errorf("orgTypeFromProto(%d) = %q, want %q", tt.orgType, got, tt.want)
+		}
+		if got := validOrgType(tt.orgType); got != tt.valid {
+			t.Errorf("validOrgType(%d) = %v, want %v", tt.orgType, got, tt.valid)
+		}
+	}
+}
+
+func assertNullTime(t *testing.T, field string, got, want sql.NullTime) {
+	t.Helper()
+	if got.Valid != want.Valid || (want.Valid && !got.Time.Equal(want.Time)) {
+		t.Fatalf("%s = %+v, want %+v", field, got, want)
+	}
+}
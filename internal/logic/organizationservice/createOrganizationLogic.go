@@ -5,10 +5,13 @@ import (
 	"database/sql"
 	"errors"
 	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/grant"
+	"github.com/ziptako/organization/internal/naming"
 	"github.com/ziptako/organization/internal/svc"
 	"github.com/ziptako/organization/organization"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"strings"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -31,9 +34,22 @@ func NewCreateOrganizationLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 
 // CreateOrganization 创建组织节点
 func (l *CreateOrganizationLogic) CreateOrganization(in *organization.CreateOrganizationRequest) (*organization.CreateOrganizationResponse, error) {
+	if strings.TrimSpace(in.Name) == "" {
+		return nil, errEmptyName
+	}
+	p, err := placement(in.BeforeId, in.AfterId)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := l.svcCtx.GrantChecker.Check(l.ctx, grant.PermCreate, in.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	l.ctx = ctx
 	// 检查祖先节点
+	var parentType, parentCode string
 	if in.ParentId != 0 {
-		_, err := l.model.FindOne(l.ctx, in.ParentId)
+		parent, err := l.model.FindOne(l.ctx, in.ParentId)
 		if err != nil {
 			if errors.Is(err, model.ErrNotFound) {
 				return nil, status.Error(codes.NotFound, "[CO002] 祖先节点不存在")
@@ -42,16 +58,64 @@ func (l *CreateOrganizationLogic) CreateOrganization(in *organization.CreateOrga
 			l.Logger.Errorf("%v: %v", eInfo, err)
 			return nil, status.Error(codes.Internal, eInfo)
 		}
+		parentType = parent.OrgType
+		parentCode = parent.Code
+	}
+	// 检查组织类型层级规则
+	if !validOrgType(in.OrgType) {
+		return nil, errInvalidOrgType
+	}
+	orgType := orgTypeFromProto(in.OrgType)
+	if orgType == "" {
+		orgType = l.svcCtx.OrgTypeSchema.DefaultType()
+	}
+	if !l.svcCtx.OrgTypeSchema.Allowed(parentType, orgType) {
+		return nil, errOrgTypeNotAllowed
+	}
+	// 检查扩展属性
+	if err := l.svcCtx.AttributeSchema.Validate(orgType, in.Attributes.AsMap()); err != nil {
+		return nil, invalidAttributesError(err)
+	}
+	// 检查同级重名
+	nameKey := l.svcCtx.NameNormalizer.Normalize(in.Name)
+	exists, err := l.model.ExistsByName(l.ctx, in.ParentId, nameKey, 0)
+	if err != nil {
+		eInfo := "[CO004] 检查同级名称失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if exists {
+		return nil, errSiblingNameExists
+	}
+	code, err := l.resolveCode(in.Code, orgType, parentCode)
+	if err != nil {
+		return nil, err
 	}
 	newOrg := &model.Organizations{
 		ParentId: sql.NullInt64{
 			Valid: in.ParentId != 0,
 			Int64: in.ParentId,
 		},
-		Name: in.Name,
+		Name:       in.Name,
+		NameKey:    nameKey,
+		OrgType:    orgType,
+		Code:       code,
+		Attributes: attributesFromProto(in.Attributes),
 	}
-	insert, err := l.model.Insert(l.ctx, newOrg)
+	insert, err := l.model.InsertAt(l.ctx, newOrg, p)
 	if err != nil {
+		if errors.Is(err, grant.ErrNotGranted) {
+			return nil, err
+		}
+		if errors.Is(err, model.ErrDuplicateName) {
+			return nil, errSiblingNameExists
+		}
+		if errors.Is(err, model.ErrDuplicateCode) {
+			return nil, errCodeExists
+		}
+		if errors.Is(err, model.ErrInvalidPlacement) {
+			return nil, errInvalidPlacement
+		}
 		eInfo := "[CO001] 创建组织失败"
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
@@ -61,3 +125,48 @@ func (l *CreateOrganizationLogic) CreateOrganization(in *organization.CreateOrga
 		Id: id,
 	}, nil
 }
+
+// resolveCode 确定新组织的编码：指定了编码时校验格式与唯一性，否则按配置的模式自动生成
+func (l *CreateOrganizationLogic) resolveCode(code, orgType, parentCode string) (string, error) {
+	if code != "" {
+		if !naming.ValidCode(code) {
+			return "", errInvalidCode
+		}
+		exists, err := l.model.ExistsByCode(l.ctx, code)
+		if err != nil {
+			eInfo := "[CO005] 检查组织编码失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return "", status.Error(codes.Internal, eInfo)
+		}
+		if exists {
+			return "", errCodeExists
+		}
+		return code, nil
+	}
+
+	generator := l.svcCtx.CodeGenerator
+	if !generator.Enabled() {
+		return "", errCodeRequired
+	}
+	var seq int64
+	if generator.UseSeq() {
+		var err error
+		if seq, err = l.model.NextCodeSeq(l.ctx); err != nil {
+			eInfo := "[CO006] 生成组织编码失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return "", status.Error(codes.Internal, eInfo)
+		}
+	}
+	code = generator.Generate(orgType, parentCode, seq)
+	if len(code) > naming.MaxCodeLength {
+		// {parent} 随层级累加，深层节点生成的编码可能超长，由调用方指定编码
+		return "", errGeneratedCodeLong
+	}
+	if !naming.ValidCode(code) {
+		// 模式配置有误（如未知占位符），生成的编码不合法
+		eInfo := "[CO006] 生成组织编码失败"
+		l.Logger.Errorf("%v: generated code %q is invalid, check Code.Pattern", eInfo, code)
+		return "", status.Error(codes.Internal, eInfo)
+	}
+	return code, nil
+}
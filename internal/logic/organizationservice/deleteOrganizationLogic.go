@@ -2,7 +2,9 @@ package organizationservicelogic
 
 import (
 	"context"
+	"errors"
 	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/grant"
 	"github.com/ziptako/organization/internal/svc"
 	"github.com/ziptako/organization/organization"
 	"google.golang.org/grpc/codes"
@@ -11,6 +13,13 @@ import (
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+// deleteModes proto 删除方式到 model 删除方式的映射
+var deleteModes = map[organization.DeleteMode]model.DeleteMode{
+	organization.DeleteMode_DELETE_MODE_REJECT_IF_CHILDREN: model.DeleteRejectIfChildren,
+	organization.DeleteMode_DELETE_MODE_CASCADE:            model.DeleteCascade,
+	organization.DeleteMode_DELETE_MODE_REHOME_CHILDREN:    model.DeleteRehomeChildren,
+}
+
 type DeleteOrganizationLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -29,20 +38,89 @@ func NewDeleteOrganizationLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 
 // DeleteOrganization 删除组织节点
 func (l *DeleteOrganizationLogic) DeleteOrganization(in *organization.DeleteOrganizationRequest) (*organization.DeleteOrganizationResponse, error) {
-	_, err := l.model.FindOne(l.ctx, in.Id)
+	mode, ok := deleteModes[in.Mode]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "[DO003] 不支持的删除方式")
+	}
+
+	check := l.svcCtx.GrantChecker.Check
+	if mode == model.DeleteCascade {
+		check = l.svcCtx.GrantChecker.CheckSubtree
+	}
+	ctx, err := check(l.ctx, grant.PermDelete, in.Id)
 	if err != nil {
-		eInfo := "[DO001] 查询失败"
-		l.Logger.Errorf("%v: %v", eInfo, err)
-		return nil, status.Error(codes.Internal, eInfo)
+		return nil, err
+	}
+	l.ctx = ctx
+
+	if mode == model.DeleteRehomeChildren {
+		if err := l.checkRehome(in.Id); err != nil {
+			return nil, err
+		}
 	}
-	err = l.model.SoftDelete(l.ctx, in.Id)
+
+	affected, err := l.model.DeleteNode(l.ctx, in.Id, mode)
 	if err != nil {
+		switch {
+		case errors.Is(err, grant.ErrNotGranted):
+			return nil, err
+		case errors.Is(err, model.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "[DO001] 组织节点不存在")
+		case errors.Is(err, model.ErrHasChildren):
+			return nil, status.Error(codes.FailedPrecondition, "[DO004] 存在未删除的子节点")
+		case errors.Is(err, model.ErrDuplicateName):
+			return nil, errSiblingNameExists
+		}
 		eInfo := "[DO002] 删除失败"
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
 	return &organization.DeleteOrganizationResponse{
-		Success: true,
+		Success:  true,
+		Affected: affected,
 	}, nil
 }
+
+// checkRehome 检查各子节点能否挂到被删除节点的父节点下：委派管理员需有权移入父节点，且子节点类型满足层级规则
+func (l *DeleteOrganizationLogic) checkRehome(id int64) error {
+	current, err := l.model.FindOne(l.ctx, id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return status.Error(codes.NotFound, "[DO001] 组织节点不存在")
+		}
+		eInfo := "[DO005] 查询组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	// 追加移入父节点的授权守卫，删除事务中与删除授权一并复核
+	ctx, err := l.svcCtx.GrantChecker.Check(l.ctx, grant.PermMove, current.ParentId.Int64)
+	if err != nil {
+		return err
+	}
+	l.ctx = ctx
+
+	var parentType string
+	if current.ParentId.Valid {
+		parent, err := l.model.FindById(l.ctx, current.ParentId.Int64)
+		if err != nil {
+			eInfo := "[DO005] 查询组织节点失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return status.Error(codes.Internal, eInfo)
+		}
+		parentType = parent.OrgType
+	}
+
+	children, err := l.model.FindByParentId(l.ctx, id)
+	if err != nil {
+		eInfo := "[DO005] 查询组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+	for _, child := range children {
+		if !l.svcCtx.OrgTypeSchema.Allowed(parentType, child.OrgType) {
+			return errOrgTypeNotAllowed
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package organizationservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
+	"github.com/ziptako/organization/internal/grant"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DisableOrganizationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.OrganizationsModel
+}
+
+func NewDisableOrganizationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisableOrganizationLogic {
+	return &DisableOrganizationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// DisableOrganization 禁用组织节点
+func (l *DisableOrganizationLogic) DisableOrganization(in *organization.DisableOrganizationRequest) (*organization.Organization, error) {
+	check := l.svcCtx.GrantChecker.Check
+	if in.Cascade {
+		check = l.svcCtx.GrantChecker.CheckSubtree
+	}
+	ctx, err := check(l.ctx, grant.PermDisable, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	l.ctx = ctx
+
+	_, err = l.model.DisableNode(l.ctx, in.Id, in.Cascade)
+	if err != nil {
+		if errors.Is(err, grant.ErrNotGranted) {
+			return nil, err
+		}
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[DS001] 组织节点不存在")
+		}
+		eInfo := "[DS002] 禁用组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	organizations, err := l.model.FindOne(l.ctx, in.Id)
+	if err != nil {
+		eInfo := "[DS003] 查询组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return converter.ModelToProtoOrganization(organizations), nil
+}
@@ -0,0 +1,67 @@
+package organizationservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
+	"github.com/ziptako/organization/internal/grant"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EnableOrganizationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.OrganizationsModel
+}
+
+func NewEnableOrganizationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EnableOrganizationLogic {
+	return &EnableOrganizationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// EnableOrganization 启用组织节点
+func (l *EnableOrganizationLogic) EnableOrganization(in *organization.EnableOrganizationRequest) (*organization.Organization, error) {
+	check := l.svcCtx.GrantChecker.Check
+	if in.Cascade {
+		check = l.svcCtx.GrantChecker.CheckSubtree
+	}
+	ctx, err := check(l.ctx, grant.PermDisable, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	l.ctx = ctx
+
+	_, err = l.model.EnableNode(l.ctx, in.Id, in.Cascade)
+	if err != nil {
+		switch {
+		case errors.Is(err, grant.ErrNotGranted):
+			return nil, err
+		case errors.Is(err, model.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "[EO001] 组织节点不存在")
+		case errors.Is(err, model.ErrParentNotActive):
+			return nil, status.Error(codes.FailedPrecondition, "[EO002] 父节点已禁用或已删除")
+		}
+		eInfo := "[EO003] 启用组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	organizations, err := l.model.FindOne(l.ctx, in.Id)
+	if err != nil {
+		eInfo := "[EO004] 查询组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return converter.ModelToProtoOrganization(organizations), nil
+}
@@ -0,0 +1,26 @@
+package organizationservicelogic
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 多个接口共用的错误，错误码保持稳定供调用方判断
+var (
+	errEmptyName         = status.Error(codes.InvalidArgument, "[ON001] 组织名称不能为空")
+	errSiblingNameExists = status.Error(codes.AlreadyExists, "[ON002] 同级组织名称已存在")
+	errOrgTypeNotAllowed = status.Error(codes.FailedPrecondition, "[ON003] 组织类型不允许挂在该父节点下")
+	errCodeRequired      = status.Error(codes.InvalidArgument, "[ON004] 组织编码不能为空")
+	errInvalidCode       = status.Error(codes.InvalidArgument, "[ON005] 组织编码只能包含字母、数字、_ . -，以字母或数字开头且不超过64个字符")
+	errCodeExists        = status.Error(codes.AlreadyExists, "[ON006] 组织编码已存在")
+	errCodeImmutable     = status.Error(codes.FailedPrecondition, "[ON007] 组织编码创建后不允许修改")
+	errPlacementConflict = status.Error(codes.InvalidArgument, "[ON009] before_id 与 after_id 不能同时指定")
+	errInvalidPlacement  = status.Error(codes.FailedPrecondition, "[ON010] 参照的兄弟节点不存在或不在同一父节点下")
+	errGeneratedCodeLong = status.Error(codes.InvalidArgument, "[ON011] 自动生成的组织编码超过64个字符（父组织编码过长），请指定编码")
+	errInvalidOrgType    = status.Error(codes.InvalidArgument, "[ON012] 组织类型不合法")
+)
+
+// invalidAttributesError 扩展属性未通过配置中属性定义的校验
+func invalidAttributesError(err error) error {
+	return status.Errorf(codes.InvalidArgument, "[ON008] 组织扩展属性不合法：%v", err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
 	"github.com/ziptako/organization/internal/svc"
 	"github.com/ziptako/organization/organization"
 	"google.golang.org/grpc/codes"
@@ -28,21 +29,42 @@ func NewGetAncestorsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetA
 	}
 }
 
-// GetAncestors 获取祖先链
+// GetAncestors 获取祖先链；指定 as_of 时按该时刻的历史版本查询
 func (l *GetAncestorsLogic) GetAncestors(in *organization.GetAncestorsRequest) (*organization.GetAncestorsResponse, error) {
-	modelOrganizations, err := l.model.FindAncestorsById(l.ctx, in.Id)
-
+	var (
+		modelOrganizations []*model.Organizations
+		err                error
+	)
+	if in.AsOf != nil {
+		modelOrganizations, err = l.model.FindAncestorsAsOf(l.ctx, in.Id, in.AsOf.AsTime())
+	} else {
+		modelOrganizations, err = l.model.FindAncestorsById(l.ctx, in.Id)
+	}
 	if err != nil {
-		if errors.Is(err, model.ErrNotFound) {
+		var cycleErr *model.CycleError
+		switch {
+		case errors.Is(err, model.ErrNotFound):
 			return nil, status.Error(codes.NotFound, "[GA001] 组织节点不存在")
+		case errors.As(err, &cycleErr):
+			eInfo := "[GA003] 组织树存在循环引用"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		case errors.Is(err, model.ErrTreeTooDeep):
+			eInfo := "[GA004] 组织层级超过上限"
+			l.Logger.Errorf("%v: id=%d", eInfo, in.Id)
+			return nil, status.Error(codes.Internal, eInfo)
 		}
 		eInfo := "[GA002] 获取祖先链失败"
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
+	// 祖先链以自身结尾
+	if in.IncludeSelf != nil && !*in.IncludeSelf {
+		modelOrganizations = modelOrganizations[:len(modelOrganizations)-1]
+	}
 	var ancestor []*organization.Organization
 	for _, org := range modelOrganizations {
-		ancestor = append(ancestor, ModelToProtoOrganization(org))
+		ancestor = append(ancestor, converter.ModelToProtoOrganization(org))
 	}
 	return &organization.GetAncestorsResponse{
 		Ancestors: ancestor,
@@ -2,7 +2,9 @@ package organizationservicelogic
 
 import (
 	"context"
+	"errors"
 	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
 	"github.com/ziptako/organization/internal/svc"
 	"github.com/ziptako/organization/organization"
 	"google.golang.org/grpc/codes"
@@ -27,16 +29,36 @@ func NewGetDescendantsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Ge
 	}
 }
 
-// GetDescendants 获取后代树
+// GetDescendants 获取后代树；指定 as_of 时按该时刻的历史版本查询
 func (l *GetDescendantsLogic) GetDescendants(in *organization.GetDescendantsRequest) (*organization.GetDescendantsResponse, error) {
-	root, err := l.model.FindDescendantsById(l.ctx, in.Id)
+	opts := model.DescendantsOptions{
+		MaxDepth:   int(in.Depth),
+		ActiveOnly: !in.IncludeDisabled,
+	}
+	if in.AsOf != nil {
+		opts.AsOf = in.AsOf.AsTime()
+	}
+	root, err := l.model.FindDescendantsTree(l.ctx, in.Id, opts)
 	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "[GD002] 组织节点不存在")
+		case errors.Is(err, model.ErrTreeTooDeep):
+			eInfo := "[GD003] 组织层级超过上限，请指定查询深度"
+			l.Logger.Errorf("%v: id=%d", eInfo, in.Id)
+			return nil, status.Error(codes.FailedPrecondition, eInfo)
+		}
 		eInfo := "[GD001] 获取后代树失败"
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
-	tree := ModelToProtoOrganizationTree(root)
+	tree := converter.ModelToProtoOrganizationTree(root)
 
+	if in.ExcludeRoot {
+		return &organization.GetDescendantsResponse{
+			Subtrees: tree.Children,
+		}, nil
+	}
 	return &organization.GetDescendantsResponse{
 		OrganizationTree: tree,
 	}, nil
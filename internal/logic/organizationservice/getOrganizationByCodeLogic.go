@@ -0,0 +1,48 @@
+package organizationservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOrganizationByCodeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.OrganizationsModel
+}
+
+func NewGetOrganizationByCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOrganizationByCodeLogic {
+	return &GetOrganizationByCodeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// GetOrganizationByCode 按组织编码获取组织节点
+func (l *GetOrganizationByCodeLogic) GetOrganizationByCode(in *organization.GetOrganizationByCodeRequest) (*organization.Organization, error) {
+	if in.Code == "" {
+		return nil, errCodeRequired
+	}
+	organizations, err := l.model.FindOneByCode(l.ctx, in.Code)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[GC001] 组织节点不存在")
+		}
+		eInfo := "[GC002] 获取组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	return converter.ModelToProtoOrganization(organizations), nil
+}
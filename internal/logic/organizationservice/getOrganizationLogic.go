@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
 	"github.com/ziptako/organization/internal/svc"
 	"github.com/ziptako/organization/organization"
 	"google.golang.org/grpc/codes"
@@ -28,9 +29,17 @@ func NewGetOrganizationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *G
 	}
 }
 
-// GetOrganization 获取组织节点
+// GetOrganization 获取组织节点；指定 as_of 时从历史版本中查询该时刻的组织
 func (l *GetOrganizationLogic) GetOrganization(in *organization.GetOrganizationRequest) (*organization.Organization, error) {
-	organizations, err := l.model.FindOne(l.ctx, in.Id)
+	var (
+		organizations *model.Organizations
+		err           error
+	)
+	if in.AsOf != nil {
+		organizations, err = l.model.FindOneAsOf(l.ctx, in.Id, in.AsOf.AsTime())
+	} else {
+		organizations, err = l.model.FindOne(l.ctx, in.Id)
+	}
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "[GO001] 组织节点不存在")
@@ -40,5 +49,5 @@ func (l *GetOrganizationLogic) GetOrganization(in *organization.GetOrganizationR
 		return nil, status.Error(codes.Internal, eInfo)
 	}
 
-	return ModelToProtoOrganization(organizations), nil
+	return converter.ModelToProtoOrganization(organizations), nil
 }
@@ -2,13 +2,31 @@ package organizationservicelogic
 
 import (
 	"context"
+	"errors"
 	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
 	"github.com/ziptako/organization/internal/svc"
 	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+const (
+	defaultListLimit = 20  // 默认分页大小
+	maxListLimit     = 500 // 最大分页大小
+)
+
+// listSortFields proto 排序字段到 model 排序字段的映射；枚举零值为 SORT_FIELD_CREATED_AT，与旧版本保持一致
+var listSortFields = map[organization.OrganizationSortField]string{
+	organization.OrganizationSortField_SORT_FIELD_CREATED_AT: model.SortFieldCreatedAt,
+	organization.OrganizationSortField_SORT_FIELD_NAME:       model.SortFieldName,
+	organization.OrganizationSortField_SORT_FIELD_UPDATED_AT: model.SortFieldUpdatedAt,
+	organization.OrganizationSortField_SORT_FIELD_SORT_ORDER: model.SortFieldSortOrder,
+}
+
 type ListOrganizationsLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -25,9 +43,81 @@ func NewListOrganizationsLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 	}
 }
 
-// ListOrganizations 分页查询子节点
+// ListOrganizations 分页查询子节点；指定 as_of 时按该时刻的历史版本查询
 func (l *ListOrganizationsLogic) ListOrganizations(in *organization.ListOrganizationsRequest) (*organization.ListOrganizationsResponse, error) {
-	// todo: add your logic here and delete this line
+	if in.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "[LO001] 偏移量不能为负数")
+	}
+	sortField, ok := listSortFields[in.GetSortField()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "[LO002] 不支持的排序字段")
+	}
+	// 未指定排序字段时，同一父节点下的子节点按兄弟顺序返回
+	if in.SortField == nil && in.ParentId != 0 {
+		sortField = model.SortFieldSortOrder
+	}
+	limit := in.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	var asOf time.Time
+	if in.AsOf != nil {
+		asOf = in.AsOf.AsTime()
+	}
+
+	// 检查父节点
+	if in.ParentId != 0 && !in.IncludeDeleted {
+		var err error
+		if asOf.IsZero() {
+			_, err = l.model.FindOne(l.ctx, in.ParentId)
+		} else {
+			_, err = l.model.FindOneAsOf(l.ctx, in.ParentId, asOf)
+		}
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "[LO003] 父节点不存在")
+			}
+			eInfo := "[LO004] 查询父节点失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
+
+	filter := &model.OrganizationsFilter{
+		ParentId:        in.ParentId,
+		IncludeDisabled: in.IncludeDisabled,
+		IncludeDeleted:  in.IncludeDeleted,
+		NamePrefix:      in.NamePrefix,
+		SortField:       sortField,
+		Desc:            in.Desc,
+		AsOf:            asOf,
+	}
+	total, err := l.model.CountByFilter(l.ctx, filter)
+	if err != nil {
+		eInfo := "[LO005] 统计组织数量失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	items := make([]*organization.Organization, 0, limit)
+	if int64(in.Offset) < total {
+		organizations, err := l.model.FindPageByFilter(l.ctx, filter, int64(limit), int64(in.Offset))
+		if err != nil {
+			eInfo := "[LO006] 查询组织列表失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		for _, org := range organizations {
+			items = append(items, converter.ModelToProtoOrganization(org))
+		}
+	}
 
-	return &organization.ListOrganizationsResponse{}, nil
+	return &organization.ListOrganizationsResponse{
+		Items: items,
+		Total: int32(total),
+	}, nil
 }
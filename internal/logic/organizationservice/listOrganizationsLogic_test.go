@@ -0,0 +1,55 @@
+package organizationservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/organization"
+)
+
+// fakeListOrganizations 记录查询使用的过滤条件，父节点均存在且没有子节点
+type fakeListOrganizations struct {
+	model.OrganizationsModel
+	filter *model.OrganizationsFilter
+}
+
+func (f *fakeListOrganizations) FindOne(_ context.Context, id int64) (*model.Organizations, error) {
+	return &model.Organizations{Id: id}, nil
+}
+
+func (f *fakeListOrganizations) CountByFilter(_ context.Context, filter *model.OrganizationsFilter) (int64, error) {
+	f.filter = filter
+	return 0, nil
+}
+
+func TestListOrganizationsSortField(t *testing.T) {
+	sortField := func(field organization.OrganizationSortField) *organization.OrganizationSortField {
+		return &field
+	}
+	tests := []struct {
+		name      string
+		parentId  int64
+		sortField *organization.OrganizationSortField
+		want      string
+	}{
+		{name: "children default", parentId: 2, want: model.SortFieldSortOrder},
+		{name: "roots default", want: model.SortFieldCreatedAt},
+		{name: "children by created at", parentId: 2, sortField: sortField(organization.OrganizationSortField_SORT_FIELD_CREATED_AT), want: model.SortFieldCreatedAt},
+		{name: "children by name", parentId: 2, sortField: sortField(organization.OrganizationSortField_SORT_FIELD_NAME), want: model.SortFieldName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			m := &fakeListOrganizations{}
+			l := &ListOrganizationsLogic{ctx: ctx, Logger: logx.WithContext(ctx), model: m}
+			if _, err := l.ListOrganizations(&organization.ListOrganizationsRequest{ParentId: tt.parentId, SortField: tt.sortField}); err != nil {
+				t.Fatal(err)
+			}
+			if m.filter.SortField != tt.want {
+				t.Fatalf("sort field = %q, want %q", m.filter.SortField, tt.want)
+			}
+		})
+	}
+}
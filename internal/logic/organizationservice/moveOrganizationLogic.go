@@ -0,0 +1,109 @@
+package organizationservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
+	"github.com/ziptako/organization/internal/grant"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MoveOrganizationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.OrganizationsModel
+}
+
+func NewMoveOrganizationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MoveOrganizationLogic {
+	return &MoveOrganizationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// MoveOrganization 移动组织节点到新的父节点下的指定位置
+func (l *MoveOrganizationLogic) MoveOrganization(in *organization.MoveOrganizationRequest) (*organization.Organization, error) {
+	p, err := placement(in.BeforeId, in.AfterId)
+	if err != nil {
+		return nil, err
+	}
+	// 委派管理员需同时有权移出该节点、移入新父节点
+	ctx, err := l.svcCtx.GrantChecker.Check(l.ctx, grant.PermMove, in.Id, in.NewParentId)
+	if err != nil {
+		return nil, err
+	}
+	l.ctx = ctx
+	if err := l.checkOrgType(in); err != nil {
+		return nil, err
+	}
+
+	err = l.model.Move(l.ctx, in.Id, in.NewParentId, p)
+	if err != nil {
+		switch {
+		case errors.Is(err, grant.ErrNotGranted):
+			return nil, err
+		case errors.Is(err, model.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "[MO001] 组织节点不存在")
+		case errors.Is(err, model.ErrInvalidParent), errors.Is(err, model.ErrCyclicParent):
+			return nil, status.Error(codes.InvalidArgument, "[MO002] 不能移动到自身或其后代节点下")
+		case errors.Is(err, model.ErrParentNotActive):
+			return nil, status.Error(codes.FailedPrecondition, "[MO003] 新父节点不存在或已禁用")
+		case errors.Is(err, model.ErrDuplicateName):
+			return nil, errSiblingNameExists
+		case errors.Is(err, model.ErrInvalidPlacement):
+			return nil, errInvalidPlacement
+		}
+		eInfo := "[MO004] 移动组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	organizations, err := l.model.FindOne(l.ctx, in.Id)
+	if err != nil {
+		eInfo := "[MO005] 查询组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return converter.ModelToProtoOrganization(organizations), nil
+}
+
+// checkOrgType 检查节点类型能否挂在新父节点下；节点或父节点不存在时交由 Move 返回对应错误
+func (l *MoveOrganizationLogic) checkOrgType(in *organization.MoveOrganizationRequest) error {
+	current, err := l.model.FindOne(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil
+		}
+		eInfo := "[MO006] 查询组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return status.Error(codes.Internal, eInfo)
+	}
+
+	var parentType string
+	if in.NewParentId != 0 {
+		parent, err := l.model.FindOne(l.ctx, in.NewParentId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return nil
+			}
+			eInfo := "[MO006] 查询组织节点失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return status.Error(codes.Internal, eInfo)
+		}
+		parentType = parent.OrgType
+	}
+
+	if !l.svcCtx.OrgTypeSchema.Allowed(parentType, current.OrgType) {
+		return errOrgTypeNotAllowed
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package organizationservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/grant"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReorderChildrenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.OrganizationsModel
+}
+
+func NewReorderChildrenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReorderChildrenLogic {
+	return &ReorderChildrenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// ReorderChildren 按给定顺序重排子节点
+func (l *ReorderChildrenLogic) ReorderChildren(in *organization.ReorderChildrenRequest) (*organization.ReorderChildrenResponse, error) {
+	if len(in.OrderedIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "[RC001] 排序列表不能为空")
+	}
+	ctx, err := l.svcCtx.GrantChecker.Check(l.ctx, grant.PermUpdate, in.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	l.ctx = ctx
+
+	err = l.model.ReorderChildren(l.ctx, in.ParentId, in.OrderedIds)
+	if err != nil {
+		if errors.Is(err, grant.ErrNotGranted) {
+			return nil, err
+		}
+		if errors.Is(err, model.ErrInvalidOrder) {
+			return nil, status.Error(codes.FailedPrecondition, "[RC002] 排序列表必须恰好包含全部子节点各一次")
+		}
+		eInfo := "[RC003] 重排子节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return &organization.ReorderChildrenResponse{
+		Success: true,
+	}, nil
+}
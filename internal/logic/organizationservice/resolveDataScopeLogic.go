@@ -0,0 +1,92 @@
+package organizationservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxCustomScopeIds 自定义数据范围的最大组织数量
+const maxCustomScopeIds = 1000
+
+type ResolveDataScopeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.OrganizationsModel
+}
+
+func NewResolveDataScopeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResolveDataScopeLogic {
+	return &ResolveDataScopeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// ResolveDataScope 解析数据权限范围，返回可访问的组织 ID
+func (l *ResolveDataScopeLogic) ResolveDataScope(in *organization.ResolveDataScopeRequest) (*organization.ResolveDataScopeResponse, error) {
+	var (
+		ids []int64
+		err error
+	)
+	switch in.Scope {
+	case organization.DataScope_DATA_SCOPE_ALL:
+		return &organization.ResolveDataScopeResponse{
+			All: true,
+		}, nil
+	case organization.DataScope_DATA_SCOPE_SELF:
+		_, err = l.model.FindOne(l.ctx, in.OrganizationId)
+		ids = []int64{in.OrganizationId}
+	case organization.DataScope_DATA_SCOPE_SELF_AND_DESCENDANTS:
+		ids, err = l.model.FindSubtreeIds(l.ctx, in.OrganizationId)
+	case organization.DataScope_DATA_SCOPE_CUSTOM:
+		if len(in.OrganizationIds) > maxCustomScopeIds {
+			return nil, status.Errorf(codes.InvalidArgument, "[RD002] 自定义数据范围最多包含 %d 个组织", maxCustomScopeIds)
+		}
+		ids, err = l.model.FindExistingIds(l.ctx, in.OrganizationIds)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "[RD001] 数据范围不能为空")
+	}
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[RD003] 组织节点不存在")
+		}
+		eInfo := "[RD004] 解析数据范围失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	threshold := l.svcCtx.Config.DataScope.CompactThreshold
+	if in.Compact || threshold > 0 && len(ids) > threshold {
+		return &organization.ResolveDataScopeResponse{
+			Ranges: compactIds(ids),
+		}, nil
+	}
+	return &organization.ResolveDataScopeResponse{
+		OrganizationIds: ids,
+	}, nil
+}
+
+// compactIds 将升序的 ID 列表合并为连续的闭区间
+func compactIds(ids []int64) []*organization.IdRange {
+	var ranges []*organization.IdRange
+	for _, id := range ids {
+		if n := len(ranges); n > 0 && ranges[n-1].End+1 == id {
+			ranges[n-1].End = id
+			continue
+		}
+		ranges = append(ranges, &organization.IdRange{
+			Start: id,
+			End:   id,
+		})
+	}
+	return ranges
+}
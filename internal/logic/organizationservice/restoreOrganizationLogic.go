@@ -0,0 +1,69 @@
+package organizationservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
+	"github.com/ziptako/organization/internal/grant"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RestoreOrganizationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.OrganizationsModel
+}
+
+func NewRestoreOrganizationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RestoreOrganizationLogic {
+	return &RestoreOrganizationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// RestoreOrganization 恢复已删除的组织节点
+func (l *RestoreOrganizationLogic) RestoreOrganization(in *organization.RestoreOrganizationRequest) (*organization.Organization, error) {
+	check := l.svcCtx.GrantChecker.Check
+	if in.Cascade {
+		check = l.svcCtx.GrantChecker.CheckSubtree
+	}
+	ctx, err := check(l.ctx, grant.PermDelete, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	l.ctx = ctx
+
+	_, err = l.model.RestoreNode(l.ctx, in.Id, in.Cascade)
+	if err != nil {
+		switch {
+		case errors.Is(err, grant.ErrNotGranted):
+			return nil, err
+		case errors.Is(err, model.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "[RO001] 已删除的组织节点不存在")
+		case errors.Is(err, model.ErrParentNotActive):
+			return nil, status.Error(codes.FailedPrecondition, "[RO002] 父节点已禁用或已删除")
+		case errors.Is(err, model.ErrDuplicateName):
+			return nil, errSiblingNameExists
+		}
+		eInfo := "[RO003] 恢复组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	organizations, err := l.model.FindOne(l.ctx, in.Id)
+	if err != nil {
+		eInfo := "[RO004] 查询组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return converter.ModelToProtoOrganization(organizations), nil
+}
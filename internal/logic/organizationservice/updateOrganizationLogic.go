@@ -2,11 +2,16 @@ package organizationservicelogic
 
 import (
 	"context"
+	"errors"
 	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
+	"github.com/ziptako/organization/internal/grant"
+	"github.com/ziptako/organization/internal/naming"
 	"github.com/ziptako/organization/internal/svc"
 	"github.com/ziptako/organization/organization"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"strings"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -27,20 +32,72 @@ func NewUpdateOrganizationLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 	}
 }
 
-// UpdateOrganization 更新组织节点名称
+// UpdateOrganization 更新组织节点名称及扩展属性，服务配置允许时可同时修改编码
 func (l *UpdateOrganizationLogic) UpdateOrganization(in *organization.UpdateOrganizationRequest) (*organization.Organization, error) {
+	if strings.TrimSpace(in.Name) == "" {
+		return nil, errEmptyName
+	}
+	ctx, err := l.svcCtx.GrantChecker.Check(l.ctx, grant.PermUpdate, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	l.ctx = ctx
 	organizations, err := l.model.FindActiveById(l.ctx, in.Id)
 	if err != nil {
 		eInfo := "[UO001] 未找到"
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
+
+	// 检查同级重名（排除自身）
+	nameKey := l.svcCtx.NameNormalizer.Normalize(in.Name)
+	exists, err := l.model.ExistsByName(l.ctx, organizations.ParentId.Int64, nameKey, organizations.Id)
+	if err != nil {
+		eInfo := "[UO003] 检查同级名称失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if exists {
+		return nil, errSiblingNameExists
+	}
+
+	if in.Code != nil && *in.Code != organizations.Code {
+		if !l.svcCtx.Config.Code.Mutable {
+			return nil, errCodeImmutable
+		}
+		if !naming.ValidCode(*in.Code) {
+			return nil, errInvalidCode
+		}
+		organizations.Code = *in.Code
+	}
+
+	if in.Attributes != nil {
+		if err := l.svcCtx.AttributeSchema.Validate(organizations.OrgType, in.Attributes.AsMap()); err != nil {
+			return nil, invalidAttributesError(err)
+		}
+		organizations.Attributes = attributesFromProto(in.Attributes)
+	}
+
 	organizations.Name = in.Name
+	organizations.NameKey = nameKey
+	// 只修改名称、编码、类型与扩展属性，organizations 更新为修改后的完整数据
 	err = l.model.Update(l.ctx, organizations)
 	if err != nil {
+		if errors.Is(err, grant.ErrNotGranted) {
+			return nil, err
+		}
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[UO004] 组织不存在或已删除")
+		}
+		if errors.Is(err, model.ErrDuplicateName) {
+			return nil, errSiblingNameExists
+		}
+		if errors.Is(err, model.ErrDuplicateCode) {
+			return nil, errCodeExists
+		}
 		eInfo := "[UO002] 更新失败"
 		l.Logger.Errorf("%v: %v", eInfo, err)
 		return nil, status.Error(codes.Internal, eInfo)
 	}
-	return ModelToProtoOrganization(organizations), nil
+	return converter.ModelToProtoOrganization(organizations), nil
 }
@@ -0,0 +1,295 @@
+package organizationservicelogic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var errWatchFailed = status.Error(codes.Internal, "[WO003] 订阅组织变更失败")
+
+type WatchOrganizationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model       model.OrganizationsModel
+	outboxModel model.OutboxModel
+}
+
+func NewWatchOrganizationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WatchOrganizationsLogic {
+	return &WatchOrganizationsLogic{
+		ctx:         ctx,
+		svcCtx:      svcCtx,
+		Logger:      logx.WithContext(ctx),
+		model:       model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+		outboxModel: model.NewOutboxModel(svcCtx.SqlConn),
+	}
+}
+
+// watchState 订阅方的推送进度
+type watchState struct {
+	rootId   int64
+	revision int64           // 已推送（或已跳过）的最新版本
+	covered  int64           // 快照已包含该版本及之前的全部移动，之后移入子树的组织需要重新同步
+	parents  map[int64]int64 // 订阅方已知的子树组织到其父节点的映射；订阅整个组织树时为 nil
+}
+
+// WatchOrganizations 订阅组织树变更，先推送子树快照，再按版本顺序推送变更事件
+func (l *WatchOrganizationsLogic) WatchOrganizations(in *organization.WatchOrganizationsRequest, stream organization.OrganizationService_WatchOrganizationsServer) error {
+	if in.RootId < 0 || in.ResumeRevision < 0 {
+		return status.Error(codes.InvalidArgument, "[WO001] 组织 ID 与版本号不能为负数")
+	}
+
+	w := &watchState{rootId: in.RootId}
+	if in.ResumeRevision == 0 {
+		if err := l.sendSnapshot(w, stream); err != nil {
+			return err
+		}
+	} else if err := l.resume(w, in.ResumeRevision, stream); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(l.svcCtx.Config.Watch.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := l.poll(w, stream); err != nil {
+			return err
+		}
+		select {
+		case <-l.ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// resume 从 revision 之后继续推送；revision 之后的事件已被清理或版本号超前时重新同步
+func (l *WatchOrganizationsLogic) resume(w *watchState, revision int64, stream organization.OrganizationService_WatchOrganizationsServer) error {
+	oldest, latest, err := l.outboxModel.FindRevisionRange(l.ctx)
+	if err != nil {
+		l.Logger.Errorf("%v: %v", errWatchFailed, err)
+		return errWatchFailed
+	}
+	if revision < oldest-1 || revision > latest {
+		return l.resync(w, fmt.Sprintf("版本 %d 已过期或不存在", revision), stream)
+	}
+
+	// 订阅方已知的子树以当前状态近似，revision 之后移入子树的组织会触发重新同步
+	if w.rootId != 0 {
+		snapshot, err := l.findSnapshot(w.rootId)
+		if err != nil {
+			return err
+		}
+		w.parents = parentsOf(snapshot.Organizations)
+	}
+	w.revision = revision
+	w.covered = revision
+	return nil
+}
+
+// poll 推送已提交的新事件，直到没有更多事件或遇到可能尚未提交的 ID 空洞
+func (l *WatchOrganizationsLogic) poll(w *watchState, stream organization.OrganizationService_WatchOrganizationsServer) error {
+	c := l.svcCtx.Config.Watch
+	for {
+		events, err := l.outboxModel.FindSince(l.ctx, w.revision, c.BatchSize)
+		if err != nil {
+			if l.ctx.Err() != nil {
+				return nil
+			}
+			l.Logger.Errorf("%v: %v", errWatchFailed, err)
+			return errWatchFailed
+		}
+
+		resynced := false
+		for _, event := range events {
+			// 事件 ID 在提交前分配，较新的事件之前出现空洞时，空洞对应的事务可能尚未提交，等待下一轮
+			if event.Id > w.revision+1 {
+				if time.Since(event.CreatedAt) < c.GapTimeout {
+					return nil
+				}
+				// 超时仍未填补：事务已回滚或运行过长，其变更提交后不会再推送，重新同步避免订阅方遗漏
+				resynced = true
+				reason := fmt.Sprintf("版本 %d 至 %d 超过 %v 未提交", w.revision+1, event.Id-1, c.GapTimeout)
+				if err := l.resync(w, reason, stream); err != nil {
+					return err
+				}
+				break
+			}
+			if resynced, err = l.push(w, event, stream); err != nil {
+				return err
+			}
+			if resynced {
+				break
+			}
+		}
+		if !resynced && len(events) < c.BatchSize {
+			return nil
+		}
+	}
+}
+
+// push 推送一条事件，返回是否因此重新同步
+func (l *WatchOrganizationsLogic) push(w *watchState, event *model.Outbox, stream organization.OrganizationService_WatchOrganizationsServer) (bool, error) {
+	change, err := converter.ModelToProtoOrganizationChanged(event)
+	if err != nil {
+		l.Logger.Errorf("%v: %v", errWatchFailed, err)
+		return false, errWatchFailed
+	}
+
+	deliver, resync := w.apply(change)
+	if resync {
+		return true, l.resync(w, fmt.Sprintf("组织 %d 移入订阅的子树", change.OrganizationId), stream)
+	}
+	w.revision = event.Id
+	if !deliver {
+		return false, nil
+	}
+	return false, stream.Send(&organization.WatchOrganizationsResponse{
+		Revision: event.Id,
+		Event: &organization.WatchOrganizationsResponse_Change{
+			Change: change,
+		},
+	})
+}
+
+// resync 通知订阅方重新同步，随后推送新的快照
+func (l *WatchOrganizationsLogic) resync(w *watchState, reason string, stream organization.OrganizationService_WatchOrganizationsServer) error {
+	err := stream.Send(&organization.WatchOrganizationsResponse{
+		Revision: w.revision,
+		Event: &organization.WatchOrganizationsResponse_Resync{
+			Resync: &organization.WatchResync{
+				Reason: reason,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return l.sendSnapshot(w, stream)
+}
+
+// sendSnapshot 分片推送子树快照，并从快照版本之后继续推送
+func (l *WatchOrganizationsLogic) sendSnapshot(w *watchState, stream organization.OrganizationService_WatchOrganizationsServer) error {
+	snapshot, err := l.findSnapshot(w.rootId)
+	if err != nil {
+		return err
+	}
+	w.revision = snapshot.Revision
+	w.covered = snapshot.Covered
+	if w.rootId != 0 {
+		w.parents = parentsOf(snapshot.Organizations)
+	}
+
+	size := l.svcCtx.Config.Watch.BatchSize
+	for start := 0; ; start += size {
+		end := min(start+size, len(snapshot.Organizations))
+		chunk := &organization.WatchSnapshot{
+			Organizations: make([]*organization.Organization, 0, end-start),
+			Last:          end == len(snapshot.Organizations),
+		}
+		for _, org := range snapshot.Organizations[start:end] {
+			chunk.Organizations = append(chunk.Organizations, converter.ModelToProtoOrganization(org))
+		}
+		err := stream.Send(&organization.WatchOrganizationsResponse{
+			Revision: snapshot.Revision,
+			Event: &organization.WatchOrganizationsResponse_Snapshot{
+				Snapshot: chunk,
+			},
+		})
+		if err != nil || chunk.Last {
+			return err
+		}
+	}
+}
+
+// findSnapshot 查询子树快照
+func (l *WatchOrganizationsLogic) findSnapshot(rootId int64) (*model.WatchSnapshot, error) {
+	snapshot, err := l.model.FindWatchSnapshot(l.ctx, rootId, l.svcCtx.Config.Watch.GapTimeout)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[WO002] 组织节点不存在")
+		}
+		l.Logger.Errorf("%v: %v", errWatchFailed, err)
+		return nil, errWatchFailed
+	}
+	return snapshot, nil
+}
+
+// apply 按事件更新订阅方已知的子树，返回是否推送该事件、是否需要重新同步
+// 组织移入子树时其下级组织没有事件，除非快照已包含该移动，否则需要重新同步
+func (w *watchState) apply(change *organization.OrganizationChanged) (deliver, resync bool) {
+	if w.parents == nil {
+		return true, false
+	}
+
+	id, parentId := change.OrganizationId, change.Organization.GetParentId()
+	_, known := w.parents[id]
+	_, parentKnown := w.parents[parentId]
+	switch change.Type {
+	case organization.ChangeType_CHANGE_TYPE_DELETED, organization.ChangeType_CHANGE_TYPE_PURGED:
+		delete(w.parents, id)
+		return known, false
+	case organization.ChangeType_CHANGE_TYPE_MOVED:
+		if id == w.rootId {
+			w.parents[id] = parentId
+			return true, false
+		}
+		_, fromKnown := w.parents[change.PreviousParentId]
+		if parentKnown {
+			if !fromKnown && change.EventId > w.covered {
+				return false, true
+			}
+			w.parents[id] = parentId
+			return true, false
+		}
+		// 移出子树的组织连同其下级一并视为移除
+		if known || fromKnown {
+			w.remove(id)
+			return true, false
+		}
+		return false, false
+	case organization.ChangeType_CHANGE_TYPE_CREATED, organization.ChangeType_CHANGE_TYPE_RESTORED:
+		if id == w.rootId || parentKnown {
+			w.parents[id] = parentId
+			return true, false
+		}
+		return known, false
+	default:
+		return known, false
+	}
+}
+
+// remove 移除组织及其已知的全部下级
+func (w *watchState) remove(id int64) {
+	removed := map[int64]bool{id: true}
+	for changed := true; changed; {
+		changed = false
+		for child, parent := range w.parents {
+			if removed[parent] && !removed[child] {
+				removed[child] = true
+				changed = true
+			}
+		}
+	}
+	for removedId := range removed {
+		delete(w.parents, removedId)
+	}
+}
+
+// parentsOf 构建组织到其父节点的映射
+func parentsOf(orgs []*model.Organizations) map[int64]int64 {
+	parents := make(map[int64]int64, len(orgs))
+	for _, org := range orgs {
+		parents[org.Id] = org.ParentId.Int64
+	}
+	return parents
+}
@@ -0,0 +1,138 @@
+package organizationservicelogic
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/ziptako/organization/organization"
+)
+
+func TestWatchStateApply(t *testing.T) {
+	// 订阅子树 1：1 -> 2 -> 3，1 -> 4；快照包含版本 10 及之前的全部移动
+	known := map[int64]int64{1: 0, 2: 1, 3: 2, 4: 1}
+	tests := []struct {
+		name     string
+		change   *organization.OrganizationChanged
+		deliver  bool
+		resync   bool
+		expected map[int64]int64
+	}{
+		{
+			name:     "create inside",
+			change:   changed(organization.ChangeType_CHANGE_TYPE_CREATED, 11, 5, 3, 0),
+			deliver:  true,
+			expected: map[int64]int64{1: 0, 2: 1, 3: 2, 4: 1, 5: 3},
+		},
+		{
+			name:     "create outside",
+			change:   changed(organization.ChangeType_CHANGE_TYPE_CREATED, 11, 5, 99, 0),
+			expected: known,
+		},
+		{
+			name:     "update inside",
+			change:   changed(organization.ChangeType_CHANGE_TYPE_UPDATED, 11, 3, 2, 0),
+			deliver:  true,
+			expected: known,
+		},
+		{
+			name:     "update outside",
+			change:   changed(organization.ChangeType_CHANGE_TYPE_UPDATED, 11, 50, 99, 0),
+			expected: known,
+		},
+		{
+			name:     "delete inside",
+			change:   changed(organization.ChangeType_CHANGE_TYPE_DELETED, 11, 4, 1, 0),
+			deliver:  true,
+			expected: map[int64]int64{1: 0, 2: 1, 3: 2},
+		},
+		{
+			name:     "move within",
+			change:   changed(organization.ChangeType_CHANGE_TYPE_MOVED, 11, 3, 4, 2),
+			deliver:  true,
+			expected: map[int64]int64{1: 0, 2: 1, 3: 4, 4: 1},
+		},
+		{
+			name:     "move out with descendants",
+			change:   changed(organization.ChangeType_CHANGE_TYPE_MOVED, 11, 2, 99, 1),
+			deliver:  true,
+			expected: map[int64]int64{1: 0, 4: 1},
+		},
+		{
+			name:     "move into after snapshot",
+			change:   changed(organization.ChangeType_CHANGE_TYPE_MOVED, 11, 50, 2, 99),
+			resync:   true,
+			expected: known,
+		},
+		{
+			name:     "move into covered by snapshot",
+			change:   changed(organization.ChangeType_CHANGE_TYPE_MOVED, 9, 50, 2, 99),
+			deliver:  true,
+			expected: map[int64]int64{1: 0, 2: 1, 3: 2, 4: 1, 50: 2},
+		},
+		{
+			name:     "move root",
+			change:   changed(organization.ChangeType_CHANGE_TYPE_MOVED, 11, 1, 99, 0),
+			deliver:  true,
+			expected: map[int64]int64{1: 99, 2: 1, 3: 2, 4: 1},
+		},
+		{
+			name:     "move outside",
+			change:   changed(organization.ChangeType_CHANGE_TYPE_MOVED, 11, 50, 98, 99),
+			expected: known,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &watchState{rootId: 1, covered: 10, parents: maps.Clone(known)}
+			deliver, resync := w.apply(tt.change)
+			if deliver != tt.deliver || resync != tt.resync {
+				t.Fatalf("apply = (%v, %v), want (%v, %v)", deliver, resync, tt.deliver, tt.resync)
+			}
+			if !maps.Equal(w.parents, tt.expected) {
+				t.Fatalf("parents = %v, want %v", w.parents, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWatchStateApplyWholeTree(t *testing.T) {
+	// 订阅整个组织树时推送全部事件
+	w := &watchState{}
+	deliver, resync := w.apply(changed(organization.ChangeType_CHANGE_TYPE_MOVED, 1, 50, 2, 99))
+	if !deliver || resync {
+		t.Fatalf("apply = (%v, %v), want (true, false)", deliver, resync)
+	}
+}
+
+func TestWatchStateRemove(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       int64
+		expected map[int64]int64
+	}{
+		{name: "leaf", id: 3, expected: map[int64]int64{1: 0, 2: 1, 4: 1, 5: 4}},
+		{name: "subtree", id: 4, expected: map[int64]int64{1: 0, 2: 1, 3: 2}},
+		{name: "root", id: 1, expected: map[int64]int64{}},
+		{name: "unknown", id: 99, expected: map[int64]int64{1: 0, 2: 1, 3: 2, 4: 1, 5: 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &watchState{rootId: 1, parents: map[int64]int64{1: 0, 2: 1, 3: 2, 4: 1, 5: 4}}
+			w.remove(tt.id)
+			if !maps.Equal(w.parents, tt.expected) {
+				t.Fatalf("parents = %v, want %v", w.parents, tt.expected)
+			}
+		})
+	}
+}
+
+// changed 构造组织变更事件
+func changed(changeType organization.ChangeType, eventId, id, parentId, previousParentId int64) *organization.OrganizationChanged {
+	return &organization.OrganizationChanged{
+		EventId:          eventId,
+		Type:             changeType,
+		OrganizationId:   id,
+		Organization:     &organization.Organization{Id: id, ParentId: parentId},
+		PreviousParentId: previousParentId,
+	}
+}
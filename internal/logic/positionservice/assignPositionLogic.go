@@ -0,0 +1,65 @@
+package positionservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AssignPositionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.PositionsModel
+}
+
+func NewAssignPositionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AssignPositionLogic {
+	return &AssignPositionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewPositionsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// AssignPosition 任命或免去职位的任职用户
+func (l *AssignPositionLogic) AssignPosition(in *organization.AssignPositionRequest) (*organization.Position, error) {
+	if in.UserId < 0 {
+		return nil, errInvalidUser
+	}
+	position, err := l.model.FindOne(l.ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, errPositionNotFound
+		}
+		eInfo := "[AP001] 查询职位失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	position.UserId = sql.NullInt64{
+		Valid: in.UserId != 0,
+		Int64: in.UserId,
+	}
+	if err := l.model.Update(l.ctx, position); err != nil {
+		eInfo := "[AP002] 任命职位失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 重新读取以获得触发器更新的 updated_at
+	updated, err := l.model.FindOne(l.ctx, in.Id)
+	if err != nil {
+		eInfo := "[AP001] 查询职位失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return ModelToProtoPosition(updated), nil
+}
@@ -0,0 +1,37 @@
+package positionservicelogic
+
+import (
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+)
+
+// positionKindPrefix PositionKind 枚举值名称的公共前缀
+const positionKindPrefix = "POSITION_KIND_"
+
+// ModelToProtoPosition 将model职位转换为proto职位
+func ModelToProtoPosition(source *model.Positions) *organization.Position {
+	return &organization.Position{
+		Id:             source.Id,
+		OrganizationId: source.OrganizationId,
+		Kind:           positionKindToProto(source.Kind),
+		Title:          source.Title,
+		UserId:         source.UserId.Int64,
+		CreateTime:     timestamppb.New(source.CreatedAt),
+		UpdateTime:     timestamppb.New(source.UpdatedAt),
+	}
+}
+
+// positionKindToProto 将库中的职位类型（如 head）转换为 proto 枚举，未知类型返回 POSITION_KIND_UNSPECIFIED
+func positionKindToProto(kind string) organization.PositionKind {
+	return organization.PositionKind(organization.PositionKind_value[positionKindPrefix+strings.ToUpper(kind)])
+}
+
+// positionKindFromProto 将 proto 枚举转换为库中的职位类型，POSITION_KIND_UNSPECIFIED 返回空字符串
+func positionKindFromProto(kind organization.PositionKind) string {
+	if kind == organization.PositionKind_POSITION_KIND_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(kind.String(), positionKindPrefix))
+}
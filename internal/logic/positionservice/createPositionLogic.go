@@ -0,0 +1,94 @@
+package positionservicelogic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// defaultPositionTitles 负责人、副职未指定名称时使用的默认名称
+var defaultPositionTitles = map[string]string{
+	model.PositionKindHead:   "负责人",
+	model.PositionKindDeputy: "副职",
+}
+
+type CreatePositionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model    model.PositionsModel
+	orgModel model.OrganizationsModel
+}
+
+func NewCreatePositionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreatePositionLogic {
+	return &CreatePositionLogic{
+		ctx:      ctx,
+		svcCtx:   svcCtx,
+		Logger:   logx.WithContext(ctx),
+		model:    model.NewPositionsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+		orgModel: model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// CreatePosition 创建组织职位
+func (l *CreatePositionLogic) CreatePosition(in *organization.CreatePositionRequest) (*organization.Position, error) {
+	kind := positionKindFromProto(in.Kind)
+	if kind == "" {
+		return nil, status.Error(codes.InvalidArgument, "[CP001] 职位类型不能为空")
+	}
+	title := strings.TrimSpace(in.Title)
+	if title == "" {
+		title = defaultPositionTitles[kind]
+	}
+	if title == "" {
+		return nil, status.Error(codes.InvalidArgument, "[CP002] 自定义职位的名称不能为空")
+	}
+	if in.UserId < 0 {
+		return nil, errInvalidUser
+	}
+
+	// 检查组织
+	if _, err := l.orgModel.FindOne(l.ctx, in.OrganizationId); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[CP003] 组织节点不存在")
+		}
+		eInfo := "[CP004] 查询组织节点失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	position := &model.Positions{
+		OrganizationId: in.OrganizationId,
+		Kind:           kind,
+		Title:          title,
+		UserId: sql.NullInt64{
+			Valid: in.UserId != 0,
+			Int64: in.UserId,
+		},
+	}
+	if _, err := l.model.Insert(l.ctx, position); err != nil {
+		if errors.Is(err, model.ErrDuplicateHead) {
+			return nil, status.Error(codes.AlreadyExists, "[CP005] 该组织已有负责人职位")
+		}
+		eInfo := "[CP006] 创建职位失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 重新读取以获得数据库生成的时间字段
+	created, err := l.model.FindOne(l.ctx, position.Id)
+	if err != nil {
+		eInfo := "[CP007] 查询职位失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return ModelToProtoPosition(created), nil
+}
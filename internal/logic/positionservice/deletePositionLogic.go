@@ -0,0 +1,49 @@
+package positionservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeletePositionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.PositionsModel
+}
+
+func NewDeletePositionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeletePositionLogic {
+	return &DeletePositionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewPositionsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// DeletePosition 删除组织职位
+func (l *DeletePositionLogic) DeletePosition(in *organization.DeletePositionRequest) (*organization.DeletePositionResponse, error) {
+	if _, err := l.model.FindOne(l.ctx, in.Id); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, errPositionNotFound
+		}
+		eInfo := "[DP001] 查询职位失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if err := l.model.Delete(l.ctx, in.Id); err != nil {
+		eInfo := "[DP002] 删除职位失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return &organization.DeletePositionResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,12 @@
+package positionservicelogic
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 多个接口共用的错误，错误码保持稳定供调用方判断
+var (
+	errInvalidUser      = status.Error(codes.InvalidArgument, "[PS001] 用户 ID 不合法")
+	errPositionNotFound = status.Error(codes.NotFound, "[PS002] 职位不存在")
+)
@@ -0,0 +1,104 @@
+package positionservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetManagerChainLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model           model.PositionsModel
+	orgModel        model.OrganizationsModel
+	membershipModel model.MembershipsModel
+}
+
+func NewGetManagerChainLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetManagerChainLogic {
+	return &GetManagerChainLogic{
+		ctx:             ctx,
+		svcCtx:          svcCtx,
+		Logger:          logx.WithContext(ctx),
+		model:           model.NewPositionsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+		orgModel:        model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+		membershipModel: model.NewMembershipsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// GetManagerChain 获取逐级的负责人链
+// 沿祖先链从起点组织逐级向上，每级取负责人职位；负责人空缺或组织已禁用的层级跳过
+func (l *GetManagerChainLogic) GetManagerChain(in *organization.GetManagerChainRequest) (*organization.GetManagerChainResponse, error) {
+	var startId, selfId int64
+	switch target := in.Target.(type) {
+	case *organization.GetManagerChainRequest_OrganizationId:
+		startId = target.OrganizationId
+	case *organization.GetManagerChainRequest_UserId:
+		primary, err := l.membershipModel.FindPrimary(l.ctx, target.UserId)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return nil, status.Error(codes.FailedPrecondition, "[MC002] 用户没有主组织")
+			}
+			eInfo := "[MC003] 查询用户主组织失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		startId, selfId = primary.OrganizationId, target.UserId
+	default:
+		return nil, status.Error(codes.InvalidArgument, "[MC001] 必须指定用户或组织")
+	}
+
+	ancestors, err := l.orgModel.FindAncestorsById(l.ctx, startId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "[MC004] 组织节点不存在")
+		}
+		eInfo := "[MC005] 获取祖先链失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	ids := make([]int64, 0, len(ancestors))
+	for _, ancestor := range ancestors {
+		ids = append(ids, ancestor.Id)
+	}
+	heads, err := l.model.FindHeads(l.ctx, ids)
+	if err != nil {
+		eInfo := "[MC006] 查询负责人失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return &organization.GetManagerChainResponse{
+		Items: managerChain(ancestors, heads, selfId),
+	}, nil
+}
+
+// managerChain 由祖先链（从根到起点组织）及各级负责人构建负责人链，从最近的上级开始；
+// 负责人空缺或组织已禁用的层级跳过，selfId 不为 0 时跳过其本人担任负责人的层级
+func managerChain(ancestors []*model.Organizations, heads []*model.Positions, selfId int64) []*organization.ManagerChainItem {
+	headOf := make(map[int64]*model.Positions, len(heads))
+	for _, head := range heads {
+		headOf[head.OrganizationId] = head
+	}
+
+	var items []*organization.ManagerChainItem
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		org := ancestors[i]
+		head, ok := headOf[org.Id]
+		if !ok || org.DisabledAt.Valid || head.UserId.Int64 == selfId {
+			continue
+		}
+		items = append(items, &organization.ManagerChainItem{
+			Organization: converter.ModelToProtoOrganization(org),
+			Position:     ModelToProtoPosition(head),
+		})
+	}
+	return items
+}
@@ -0,0 +1,93 @@
+package positionservicelogic
+
+import (
+	"database/sql"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ziptako/organization/db/model"
+)
+
+func TestManagerChain(t *testing.T) {
+	disabled := sql.NullTime{Valid: true, Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	// 祖先链 1 -> 2 -> 3 -> 4，从根开始
+	ancestors := func(disabledIds ...int64) []*model.Organizations {
+		var orgs []*model.Organizations
+		for id := int64(1); id <= 4; id++ {
+			org := &model.Organizations{Id: id, ParentId: sql.NullInt64{Valid: id > 1, Int64: id - 1}}
+			if slices.Contains(disabledIds, id) {
+				org.DisabledAt = disabled
+			}
+			orgs = append(orgs, org)
+		}
+		return orgs
+	}
+	head := func(organizationId, userId int64) *model.Positions {
+		return &model.Positions{
+			Id:             organizationId * 10,
+			OrganizationId: organizationId,
+			Kind:           model.PositionKindHead,
+			UserId:         sql.NullInt64{Valid: true, Int64: userId},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		ancestors []*model.Organizations
+		heads     []*model.Positions
+		selfId    int64
+		want      []int64 // 负责人链上各级的组织 ID
+	}{
+		{
+			name:      "every level",
+			ancestors: ancestors(),
+			heads:     []*model.Positions{head(1, 101), head(2, 102), head(3, 103), head(4, 104)},
+			want:      []int64{4, 3, 2, 1},
+		},
+		{
+			name:      "vacant levels",
+			ancestors: ancestors(),
+			heads:     []*model.Positions{head(1, 101), head(3, 103)},
+			want:      []int64{3, 1},
+		},
+		{
+			name:      "disabled levels",
+			ancestors: ancestors(2, 4),
+			heads:     []*model.Positions{head(1, 101), head(2, 102), head(3, 103), head(4, 104)},
+			want:      []int64{3, 1},
+		},
+		{
+			name:      "vacant and disabled",
+			ancestors: ancestors(3),
+			heads:     []*model.Positions{head(1, 101), head(3, 103)},
+			want:      []int64{1},
+		},
+		{
+			name:      "self as head",
+			ancestors: ancestors(),
+			heads:     []*model.Positions{head(1, 101), head(3, 103), head(4, 104)},
+			selfId:    104,
+			want:      []int64{3, 1},
+		},
+		{
+			name:      "no heads",
+			ancestors: ancestors(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := managerChain(tt.ancestors, tt.heads, tt.selfId)
+			var got []int64
+			for _, item := range items {
+				if item.Position.OrganizationId != item.Organization.Id {
+					t.Fatalf("position %d belongs to organization %d, listed under %d", item.Position.Id, item.Position.OrganizationId, item.Organization.Id)
+				}
+				got = append(got, item.Organization.Id)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("chain = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package positionservicelogic
+
+import (
+	"context"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListPositionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.PositionsModel
+}
+
+func NewListPositionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPositionsLogic {
+	return &ListPositionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewPositionsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// ListPositions 查询组织的全部职位
+func (l *ListPositionsLogic) ListPositions(in *organization.ListPositionsRequest) (*organization.ListPositionsResponse, error) {
+	positions, err := l.model.FindByOrganizationId(l.ctx, in.OrganizationId)
+	if err != nil {
+		eInfo := "[LP001] 查询职位失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	items := make([]*organization.Position, 0, len(positions))
+	for _, position := range positions {
+		items = append(items, ModelToProtoPosition(position))
+	}
+	return &organization.ListPositionsResponse{
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package webhookservicelogic
+
+import (
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+)
+
+// changeTypePrefix ChangeType 枚举值名称的公共前缀
+const changeTypePrefix = "CHANGE_TYPE_"
+
+// deliveryStatusPrefix DeliveryStatus 枚举值名称的公共前缀
+const deliveryStatusPrefix = "DELIVERY_STATUS_"
+
+// ModelToProtoWebhook 将model订阅转换为proto订阅，不包含签名密钥
+func ModelToProtoWebhook(source *model.Webhooks) *organization.Webhook {
+	eventTypes := make([]organization.ChangeType, 0, len(source.EventTypes))
+	for _, eventType := range source.EventTypes {
+		eventTypes = append(eventTypes, changeTypeToProto(eventType))
+	}
+	return &organization.Webhook{
+		Id:                 source.Id,
+		Url:                source.Url,
+		EventTypes:         eventTypes,
+		RootOrganizationId: source.RootOrganizationId.Int64,
+		Description:        source.Description,
+		CreateTime:         timestamppb.New(source.CreatedAt),
+		UpdateTime:         timestamppb.New(source.UpdatedAt),
+	}
+}
+
+// ModelToProtoWebhookAttempt 将model投递尝试转换为proto投递尝试
+func ModelToProtoWebhookAttempt(source *model.WebhookAttemptDetail) *organization.WebhookAttempt {
+	return &organization.WebhookAttempt{
+		Id:             source.Id,
+		DeliveryId:     source.DeliveryId,
+		EventId:        source.EventId,
+		EventType:      changeTypeToProto(source.EventType),
+		Attempt:        int32(source.Attempt),
+		StatusCode:     int32(source.StatusCode),
+		Error:          source.Error.String,
+		DurationMs:     source.DurationMs,
+		DeliveryStatus: organization.DeliveryStatus(organization.DeliveryStatus_value[deliveryStatusPrefix+strings.ToUpper(source.DeliveryStatus)]),
+		CreateTime:     timestamppb.New(source.CreatedAt),
+	}
+}
+
+// changeTypeToProto 将库中的变更类型转换为 proto 枚举
+func changeTypeToProto(changeType string) organization.ChangeType {
+	return organization.ChangeType(organization.ChangeType_value[changeTypePrefix+strings.ToUpper(changeType)])
+}
+
+// changeTypeFromProto 将 proto 枚举转换为库中的变更类型，CHANGE_TYPE_UNSPECIFIED 及未知取值返回空字符串
+func changeTypeFromProto(changeType organization.ChangeType) string {
+	if changeType == organization.ChangeType_CHANGE_TYPE_UNSPECIFIED {
+		return ""
+	}
+	if _, ok := organization.ChangeType_name[int32(changeType)]; !ok {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(changeType.String(), changeTypePrefix))
+}
+
+// deliveryStatusFromProto 将 proto 枚举转换为库中的投递状态，DELIVERY_STATUS_UNSPECIFIED 及未知取值返回空字符串
+func deliveryStatusFromProto(deliveryStatus organization.DeliveryStatus) string {
+	if deliveryStatus == organization.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED {
+		return ""
+	}
+	if _, ok := organization.DeliveryStatus_name[int32(deliveryStatus)]; !ok {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(deliveryStatus.String(), deliveryStatusPrefix))
+}
@@ -0,0 +1,111 @@
+package webhookservicelogic
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"slices"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// minSecretLength 调用方指定的签名密钥的最小长度
+const minSecretLength = 16
+
+type CreateWebhookLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model    model.WebhooksModel
+	orgModel model.OrganizationsModel
+}
+
+func NewCreateWebhookLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateWebhookLogic {
+	return &CreateWebhookLogic{
+		ctx:      ctx,
+		svcCtx:   svcCtx,
+		Logger:   logx.WithContext(ctx),
+		model:    model.NewWebhooksModel(svcCtx.SqlConn),
+		orgModel: model.NewOrganizationsModel(svcCtx.SqlConn, svcCtx.CacheConf),
+	}
+}
+
+// CreateWebhook 创建 Webhook 订阅，签名密钥仅在创建时返回
+func (l *CreateWebhookLogic) CreateWebhook(in *organization.CreateWebhookRequest) (*organization.CreateWebhookResponse, error) {
+	u, err := url.Parse(in.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, status.Error(codes.InvalidArgument, "[CW001] 推送地址不合法")
+	}
+	eventTypes := make([]string, 0, len(in.EventTypes))
+	for _, t := range in.EventTypes {
+		eventType := changeTypeFromProto(t)
+		if eventType == "" {
+			return nil, status.Error(codes.InvalidArgument, "[CW002] 变更类型不合法")
+		}
+		if !slices.Contains(eventTypes, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+	if in.RootOrganizationId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "[CW003] 组织 ID 不合法")
+	}
+	if in.Secret != "" && len(in.Secret) < minSecretLength {
+		return nil, status.Errorf(codes.InvalidArgument, "[CW004] 签名密钥至少 %d 个字符", minSecretLength)
+	}
+
+	// 检查子树根节点
+	if in.RootOrganizationId > 0 {
+		if _, err := l.orgModel.FindOne(l.ctx, in.RootOrganizationId); err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "[CW005] 组织节点不存在")
+			}
+			eInfo := "[CW006] 查询组织节点失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+	}
+
+	secret := in.Secret
+	if secret == "" {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			eInfo := "[CW007] 生成签名密钥失败"
+			l.Logger.Errorf("%v: %v", eInfo, err)
+			return nil, status.Error(codes.Internal, eInfo)
+		}
+		secret = hex.EncodeToString(b)
+	}
+
+	webhook := &model.Webhooks{
+		Url:                in.Url,
+		EventTypes:         eventTypes,
+		RootOrganizationId: sql.NullInt64{Valid: in.RootOrganizationId > 0, Int64: in.RootOrganizationId},
+		Secret:             secret,
+		Description:        in.Description,
+	}
+	if _, err := l.model.Insert(l.ctx, webhook); err != nil {
+		eInfo := "[CW008] 创建 Webhook 订阅失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	// 重新读取以获得数据库生成的时间字段
+	saved, err := l.model.FindOne(l.ctx, webhook.Id)
+	if err != nil {
+		eInfo := "[CW009] 查询 Webhook 订阅失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return &organization.CreateWebhookResponse{
+		Webhook: ModelToProtoWebhook(saved),
+		Secret:  secret,
+	}, nil
+}
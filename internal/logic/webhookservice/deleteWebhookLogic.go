@@ -0,0 +1,49 @@
+package webhookservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteWebhookLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.WebhooksModel
+}
+
+func NewDeleteWebhookLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteWebhookLogic {
+	return &DeleteWebhookLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewWebhooksModel(svcCtx.SqlConn),
+	}
+}
+
+// DeleteWebhook 删除 Webhook 订阅，其投递记录与尝试记录随之级联删除
+func (l *DeleteWebhookLogic) DeleteWebhook(in *organization.DeleteWebhookRequest) (*organization.DeleteWebhookResponse, error) {
+	if _, err := l.model.FindOne(l.ctx, in.Id); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, errWebhookNotFound
+		}
+		eInfo := "[DW001] 查询 Webhook 订阅失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	if err := l.model.Delete(l.ctx, in.Id); err != nil {
+		eInfo := "[DW002] 删除 Webhook 订阅失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+	return &organization.DeleteWebhookResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,11 @@
+package webhookservicelogic
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 多个接口共用的错误，错误码保持稳定供调用方判断
+var (
+	errWebhookNotFound = status.Error(codes.NotFound, "[WH001] Webhook 订阅不存在")
+)
@@ -0,0 +1,84 @@
+package webhookservicelogic
+
+import (
+	"context"
+	"errors"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 投递尝试分页大小
+const (
+	defaultAttemptsLimit = 20
+	maxAttemptsLimit     = 500
+)
+
+type ListWebhookAttemptsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model        model.WebhookAttemptsModel
+	webhookModel model.WebhooksModel
+}
+
+func NewListWebhookAttemptsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListWebhookAttemptsLogic {
+	return &ListWebhookAttemptsLogic{
+		ctx:          ctx,
+		svcCtx:       svcCtx,
+		Logger:       logx.WithContext(ctx),
+		model:        model.NewWebhookAttemptsModel(svcCtx.SqlConn),
+		webhookModel: model.NewWebhooksModel(svcCtx.SqlConn),
+	}
+}
+
+// ListWebhookAttempts 按时间倒序查询订阅的投递尝试记录
+func (l *ListWebhookAttemptsLogic) ListWebhookAttempts(in *organization.ListWebhookAttemptsRequest) (*organization.ListWebhookAttemptsResponse, error) {
+	filter := &model.WebhookAttemptsFilter{
+		WebhookId: in.WebhookId,
+		BeforeId:  in.BeforeId,
+	}
+	if in.Status != organization.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED {
+		filter.DeliveryStatus = deliveryStatusFromProto(in.Status)
+		if filter.DeliveryStatus == "" {
+			return nil, status.Error(codes.InvalidArgument, "[LA001] 投递状态不合法")
+		}
+	}
+	limit := int64(in.Limit)
+	if limit <= 0 {
+		limit = defaultAttemptsLimit
+	}
+	limit = min(limit, maxAttemptsLimit)
+
+	if _, err := l.webhookModel.FindOne(l.ctx, in.WebhookId); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, errWebhookNotFound
+		}
+		eInfo := "[LA002] 查询 Webhook 订阅失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	attempts, err := l.model.FindPage(l.ctx, filter, limit)
+	if err != nil {
+		eInfo := "[LA003] 查询投递记录失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	resp := &organization.ListWebhookAttemptsResponse{
+		Items: make([]*organization.WebhookAttempt, 0, len(attempts)),
+	}
+	for _, attempt := range attempts {
+		resp.Items = append(resp.Items, ModelToProtoWebhookAttempt(attempt))
+	}
+	// 满页时可能还有更早的记录
+	if int64(len(attempts)) == limit {
+		resp.NextBeforeId = attempts[len(attempts)-1].Id
+	}
+	return resp, nil
+}
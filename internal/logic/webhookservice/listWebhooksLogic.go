@@ -0,0 +1,46 @@
+package webhookservicelogic
+
+import (
+	"context"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListWebhooksLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	model model.WebhooksModel
+}
+
+func NewListWebhooksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListWebhooksLogic {
+	return &ListWebhooksLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		model:  model.NewWebhooksModel(svcCtx.SqlConn),
+	}
+}
+
+// ListWebhooks 查询全部 Webhook 订阅
+func (l *ListWebhooksLogic) ListWebhooks(in *organization.ListWebhooksRequest) (*organization.ListWebhooksResponse, error) {
+	webhooks, err := l.model.FindAll(l.ctx)
+	if err != nil {
+		eInfo := "[LW001] 查询 Webhook 订阅失败"
+		l.Logger.Errorf("%v: %v", eInfo, err)
+		return nil, status.Error(codes.Internal, eInfo)
+	}
+
+	items := make([]*organization.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		items = append(items, ModelToProtoWebhook(webhook))
+	}
+	return &organization.ListWebhooksResponse{
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package naming
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ziptako/organization/internal/config"
+)
+
+// MaxCodeLength 组织编码的最大长度，与数据库 code 列的长度一致
+const MaxCodeLength = 64
+
+// codePattern 组织编码格式：字母或数字开头，可包含 _ . -，最长 64 个字符
+var codePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,63}$`)
+
+// codePlaceholder 编码模式中的占位符：{type}、{parent}、{seq}、{seq:N}
+var codePlaceholder = regexp.MustCompile(`\{(type|parent|seq)(?::(\d+))?\}`)
+
+// ValidCode 判断编码格式是否合法
+func ValidCode(code string) bool {
+	return codePattern.MatchString(code)
+}
+
+// CodeGenerator 组织编码生成器，按配置的模式生成编码
+// 占位符：{type} 为大写的组织类型，{parent} 为父组织编码（根节点为空），{seq} 为序列号，{seq:N} 为补零到 N 位的序列号，
+// 如 {type}-{seq:6} 生成 DEPARTMENT-000042
+type CodeGenerator struct {
+	pattern string
+	useSeq  bool
+}
+
+// NewCodeGenerator 根据配置创建编码生成器
+func NewCodeGenerator(c config.CodeConf) *CodeGenerator {
+	useSeq := false
+	for _, match := range codePlaceholder.FindAllStringSubmatch(c.Pattern, -1) {
+		if match[1] == "seq" {
+			useSeq = true
+		}
+	}
+	return &CodeGenerator{
+		pattern: c.Pattern,
+		useSeq:  useSeq,
+	}
+}
+
+// Enabled 是否配置了自动生成模式
+func (g *CodeGenerator) Enabled() bool {
+	return g.pattern != ""
+}
+
+// UseSeq 模式中是否包含序列号，包含时调用方需先获取序列号
+func (g *CodeGenerator) UseSeq() bool {
+	return g.useSeq
+}
+
+// Generate 按模式生成编码；根节点 {parent} 为空时去掉开头多余的分隔符
+func (g *CodeGenerator) Generate(orgType, parentCode string, seq int64) string {
+	code := codePlaceholder.ReplaceAllStringFunc(g.pattern, func(placeholder string) string {
+		match := codePlaceholder.FindStringSubmatch(placeholder)
+		switch match[1] {
+		case "type":
+			return strings.ToUpper(orgType)
+		case "parent":
+			return parentCode
+		default:
+			if match[2] == "" {
+				return strconv.FormatInt(seq, 10)
+			}
+			width, _ := strconv.Atoi(match[2])
+			return fmt.Sprintf("%0*d", width, seq)
+		}
+	})
+	return strings.TrimLeft(code, "_.-")
+}
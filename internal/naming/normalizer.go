@@ -0,0 +1,37 @@
+package naming
+
+import (
+	"strings"
+
+	"github.com/ziptako/organization/internal/config"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/width"
+)
+
+// Normalizer 组织名称规范化器，生成用于同级重名校验的 name_key
+type Normalizer struct {
+	c    config.NameNormalizeConf
+	fold cases.Caser
+}
+
+// NewNormalizer 根据配置创建名称规范化器
+func NewNormalizer(c config.NameNormalizeConf) *Normalizer {
+	return &Normalizer{
+		c:    c,
+		fold: cases.Fold(),
+	}
+}
+
+// Normalize 返回名称的规范化形式
+func (n *Normalizer) Normalize(name string) string {
+	if n.c.TrimSpace {
+		name = strings.TrimSpace(name)
+	}
+	if n.c.FoldWidth {
+		name = width.Fold.String(name)
+	}
+	if n.c.FoldCase {
+		name = n.fold.String(name)
+	}
+	return name
+}
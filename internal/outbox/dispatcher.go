@@ -0,0 +1,154 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/config"
+	"github.com/ziptako/organization/internal/converter"
+)
+
+// Dispatcher 后台投递器，轮询发件箱并将组织变更事件交给 Publisher 发布
+// 事件在事务外发布、逐条标记结果；失败的事件按指数退避重试（至少一次），达到 MaxAttempts 后转为死信；同一组织的事件按顺序发布
+type Dispatcher struct {
+	c         config.OutboxConf
+	model     model.OutboxModel
+	publisher Publisher
+	fanout    model.FanoutFunc
+	done      chan struct{}
+	stopped   chan struct{}
+}
+
+// NewDispatcher 创建投递器，fanout 不为空时在领取事件的事务中为事件创建 Webhook 投递记录
+func NewDispatcher(c config.OutboxConf, m model.OutboxModel, publisher Publisher, fanout model.FanoutFunc) *Dispatcher {
+	return &Dispatcher{
+		c:         c,
+		model:     m,
+		publisher: publisher,
+		fanout:    fanout,
+		done:      make(chan struct{}),
+		stopped:   make(chan struct{}),
+	}
+}
+
+// MustNewDispatcher 按配置创建投递器，fanout 为 Webhook 订阅分发，可为空；配置错误时 panic
+func MustNewDispatcher(c config.OutboxConf, conn sqlx.SqlConn, fanout model.FanoutFunc) *Dispatcher {
+	publisher, err := NewPublisher(c)
+	logx.Must(err)
+	return NewDispatcher(c, model.NewOutboxModel(conn), publisher, fanout)
+}
+
+// Start 开始投递，阻塞直到 Stop 被调用
+func (d *Dispatcher) Start() {
+	defer close(d.stopped)
+
+	ticker := time.NewTicker(d.c.Interval)
+	defer ticker.Stop()
+	lastPurge := time.Time{}
+	for {
+		d.dispatch()
+		if d.c.Retention > 0 && time.Since(lastPurge) >= time.Hour {
+			d.purge()
+			lastPurge = time.Now()
+		}
+
+		select {
+		case <-d.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop 停止投递，等待进行中的一批完成
+func (d *Dispatcher) Stop() {
+	close(d.done)
+	<-d.stopped
+}
+
+// dispatch 连续领取并投递事件，直到没有到期的事件或收到停止信号
+func (d *Dispatcher) dispatch() {
+	ctx := context.Background()
+	for {
+		events, err := d.model.ClaimPending(ctx, d.c.BatchSize, d.c.Lease, d.fanout)
+		if err != nil {
+			logx.Errorf("claim outbox events: %v", err)
+			return
+		}
+
+		// 在租期内逐条发布并标记结果，超出租期的事件留待重新领取，避免与其他实例重复且乱序地发布
+		deadline := time.Now().Add(d.c.Lease)
+		for _, event := range events {
+			if time.Now().After(deadline) {
+				break
+			}
+			d.deliver(ctx, deadline, event)
+		}
+		if len(events) < d.c.BatchSize {
+			return
+		}
+		select {
+		case <-d.done:
+			return
+		default:
+		}
+	}
+}
+
+// deliver 发布一条事件并记录结果：失败的累加次数并按退避推迟，达到 MaxAttempts 时转为死信
+func (d *Dispatcher) deliver(ctx context.Context, deadline time.Time, row *model.Outbox) {
+	publishCtx, cancel := context.WithDeadline(ctx, deadline)
+	err := d.publish(publishCtx, row)
+	cancel()
+	if err == nil {
+		if err := d.model.MarkPublished(ctx, row.Id); err != nil {
+			logx.WithContext(ctx).Errorf("mark outbox event %d published: %v", row.Id, err)
+		}
+		return
+	}
+
+	attempts := row.Attempts + 1
+	dead := d.c.MaxAttempts > 0 && attempts >= d.c.MaxAttempts
+	if dead {
+		logx.WithContext(ctx).Errorf("outbox event %d is dead after %d attempts", row.Id, attempts)
+	}
+	if err := d.model.MarkFailed(ctx, row.Id, time.Now().Add(d.backoff(attempts)), dead, err.Error()); err != nil {
+		logx.WithContext(ctx).Errorf("mark outbox event %d failed: %v", row.Id, err)
+	}
+}
+
+// publish 将发件箱记录转换为事件并发布
+func (d *Dispatcher) publish(ctx context.Context, row *model.Outbox) error {
+	event, err := converter.ModelToProtoOrganizationChanged(row)
+	if err == nil {
+		err = d.publisher.Publish(ctx, event)
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("publish outbox event %d (attempt %d): %v", row.Id, row.Attempts+1, err)
+	}
+	return err
+}
+
+// backoff 第 attempts 次失败后的重试等待时间：MinBackoff 逐次翻倍，不超过 MaxBackoff
+func (d *Dispatcher) backoff(attempts int64) time.Duration {
+	wait := d.c.MinBackoff
+	for i := int64(1); i < attempts && wait < d.c.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, d.c.MaxBackoff)
+}
+
+// purge 清理超过保留时长的已投递及死信事件
+func (d *Dispatcher) purge() {
+	n, err := d.model.PurgePublished(context.Background(), time.Now().Add(-d.c.Retention))
+	if err != nil {
+		logx.Errorf("purge outbox: %v", err)
+		return
+	}
+	if n > 0 {
+		logx.Infof("purged %d published outbox events", n)
+	}
+}
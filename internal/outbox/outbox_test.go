@@ -0,0 +1,202 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/config"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// failure 一次 MarkFailed 调用
+type failure struct {
+	id      int64
+	retryAt time.Time
+	dead    bool
+	reason  string
+}
+
+// fakeOutbox 内存中的发件箱，首次领取返回全部事件，之后没有到期的事件
+type fakeOutbox struct {
+	model.OutboxModel
+	events    []*model.Outbox
+	claims    int
+	published []int64
+	failures  []failure
+}
+
+func (f *fakeOutbox) ClaimPending(_ context.Context, limit int, _ time.Duration, _ model.FanoutFunc) ([]*model.Outbox, error) {
+	f.claims++
+	if f.claims > 1 {
+		return nil, nil
+	}
+	return f.events[:min(limit, len(f.events))], nil
+}
+
+func (f *fakeOutbox) MarkPublished(_ context.Context, id int64) error {
+	f.published = append(f.published, id)
+	return nil
+}
+
+func (f *fakeOutbox) MarkFailed(_ context.Context, id int64, retryAt time.Time, dead bool, reason string) error {
+	f.failures = append(f.failures, failure{id: id, retryAt: retryAt, dead: dead, reason: reason})
+	return nil
+}
+
+// fakePublisher 记录发布的事件 ID，每次发布耗时 delay，返回 err
+type fakePublisher struct {
+	delay time.Duration
+	err   error
+	ids   []int64
+}
+
+func (p *fakePublisher) Publish(_ context.Context, event *organization.OrganizationChanged) error {
+	time.Sleep(p.delay)
+	p.ids = append(p.ids, event.EventId)
+	return p.err
+}
+
+func testEvent(id, attempts int64) *model.Outbox {
+	return &model.Outbox{
+		Id:             id,
+		OrganizationId: 7,
+		EventType:      model.ChangeUpdated,
+		Payload:        `{"id": 7, "name": "研发部", "org_type": "department", "path": "/1/7/"}`,
+		Attempts:       attempts,
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	d := NewDispatcher(config.OutboxConf{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}, nil, nil, nil)
+	tests := []struct {
+		attempts int64
+		want     time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 5 * time.Second},
+		{40, 5 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := d.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	unavailable := fmt.Errorf("broker unavailable")
+	tests := []struct {
+		name        string
+		event       *model.Outbox
+		err         error
+		maxAttempts int64
+		published   bool
+		dead        bool
+		wait        time.Duration // 失败后的重试等待时间
+	}{
+		{name: "published", event: testEvent(1, 0), maxAttempts: 3, published: true},
+		{name: "first failure", event: testEvent(1, 0), err: unavailable, maxAttempts: 3, wait: time.Second},
+		{name: "retry failure", event: testEvent(1, 1), err: unavailable, maxAttempts: 3, wait: 2 * time.Second},
+		{name: "dead at max attempts", event: testEvent(1, 2), err: unavailable, maxAttempts: 3, dead: true, wait: 4 * time.Second},
+		{name: "unlimited attempts", event: testEvent(1, 99), err: unavailable, wait: time.Minute},
+		{name: "invalid payload", event: &model.Outbox{Id: 1, Payload: "{"}, maxAttempts: 3, wait: time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := config.OutboxConf{Lease: time.Minute, MinBackoff: time.Second, MaxBackoff: time.Minute, MaxAttempts: tt.maxAttempts}
+			outbox := &fakeOutbox{}
+			d := NewDispatcher(c, outbox, &fakePublisher{err: tt.err}, nil)
+
+			before := time.Now()
+			d.deliver(context.Background(), before.Add(c.Lease), tt.event)
+			if tt.published {
+				if len(outbox.published) != 1 || len(outbox.failures) != 0 {
+					t.Fatalf("published = %v, failures = %+v; want event published", outbox.published, outbox.failures)
+				}
+				return
+			}
+
+			if len(outbox.published) != 0 || len(outbox.failures) != 1 {
+				t.Fatalf("published = %v, failures = %+v; want one failure", outbox.published, outbox.failures)
+			}
+			got := outbox.failures[0]
+			if got.id != tt.event.Id || got.dead != tt.dead || got.reason == "" {
+				t.Errorf("failure = %+v, want event %d dead %v with a reason", got, tt.event.Id, tt.dead)
+			}
+			if wait := got.retryAt.Sub(before); wait < tt.wait || wait > tt.wait+time.Second {
+				t.Errorf("retry after %v, want %v", wait, tt.wait)
+			}
+		})
+	}
+}
+
+func TestDispatchStopsAtLeaseDeadline(t *testing.T) {
+	c := config.OutboxConf{BatchSize: 10, Lease: 300 * time.Millisecond, MinBackoff: time.Second, MaxBackoff: time.Minute}
+	outbox := &fakeOutbox{events: []*model.Outbox{testEvent(1, 0), testEvent(2, 0), testEvent(3, 0), testEvent(4, 0)}}
+	publisher := &fakePublisher{delay: 200 * time.Millisecond}
+	NewDispatcher(c, outbox, publisher, nil).dispatch()
+
+	// 第二条在租期内开始发布，之后已超出租期，剩余事件留待重新领取，不标记失败
+	if len(publisher.ids) != 2 || len(outbox.published) != 2 || len(outbox.failures) != 0 {
+		t.Fatalf("published %v, marked %v, failures %+v; want events 1 and 2 only", publisher.ids, outbox.published, outbox.failures)
+	}
+	if outbox.claims != 1 {
+		t.Errorf("claims = %d, want 1 for a partial batch", outbox.claims)
+	}
+}
+
+func TestWebhookPublisher(t *testing.T) {
+	var got *http.Request
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	p, err := NewWebhookPublisher(config.WebhookConf{Url: srv.URL, Timeout: time.Second, Headers: map[string]string{"Authorization": "Bearer token"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := &organization.OrganizationChanged{EventId: 42, Type: organization.ChangeType_CHANGE_TYPE_MOVED, OrganizationId: 7}
+	if err := p.Publish(context.Background(), event); err != nil {
+		t.Fatal(err)
+	}
+	if got.Header.Get("X-Org-Event-Id") != "42" || got.Header.Get("Authorization") != "Bearer token" || got.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("headers = %v", got.Header)
+	}
+	var received organization.OrganizationChanged
+	if err := protojson.Unmarshal(gotBody, &received); err != nil || received.EventId != 42 || received.OrganizationId != 7 {
+		t.Errorf("body = %s, %v", gotBody, err)
+	}
+}
+
+func TestWebhookPublisherRejectsNon2xx(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	p, err := NewWebhookPublisher(config.WebhookConf{Url: srv.URL, Timeout: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.Publish(context.Background(), &organization.OrganizationChanged{EventId: 1})
+	if err == nil || !strings.Contains(err.Error(), "503") {
+		t.Fatalf("err = %v, want the 503 status", err)
+	}
+
+	if _, err := NewWebhookPublisher(config.WebhookConf{}); err == nil {
+		t.Error("NewWebhookPublisher without url succeeded")
+	}
+}
@@ -0,0 +1,104 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/ziptako/organization/internal/config"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// 发布方式
+const (
+	PublisherLog     = "log"
+	PublisherWebhook = "webhook"
+)
+
+// Publisher 组织变更事件的发布方式；返回错误时事件稍后重试，同一事件可能被发布多次
+type Publisher interface {
+	Publish(ctx context.Context, event *organization.OrganizationChanged) error
+}
+
+// NewPublisher 按配置创建发布方式
+func NewPublisher(c config.OutboxConf) (Publisher, error) {
+	switch c.Publisher {
+	case PublisherLog:
+		return NewLogPublisher(), nil
+	case PublisherWebhook:
+		return NewWebhookPublisher(c.Webhook)
+	default:
+		return nil, fmt.Errorf("unknown outbox publisher %q", c.Publisher)
+	}
+}
+
+// LogPublisher 将事件以 JSON 写入日志，用于调试或由日志采集系统转发
+type LogPublisher struct{}
+
+// NewLogPublisher 创建日志发布方式
+func NewLogPublisher() *LogPublisher {
+	return &LogPublisher{}
+}
+
+// Publish 将事件写入日志
+func (p *LogPublisher) Publish(ctx context.Context, event *organization.OrganizationChanged) error {
+	body, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+	logx.WithContext(ctx).Infof("organization changed: %s", body)
+	return nil
+}
+
+// WebhookPublisher 将事件以 JSON 通过 HTTP POST 推送到固定地址，请求头 X-Org-Event-Id 携带事件 ID 供接收方去重
+type WebhookPublisher struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewWebhookPublisher 创建 HTTP 推送发布方式
+func NewWebhookPublisher(c config.WebhookConf) (*WebhookPublisher, error) {
+	if c.Url == "" {
+		return nil, fmt.Errorf("outbox webhook url is required")
+	}
+	return &WebhookPublisher{
+		url:     c.Url,
+		headers: c.Headers,
+		client:  &http.Client{Timeout: c.Timeout},
+	}, nil
+}
+
+// Publish 推送事件，接收方返回非 2xx 状态码视为失败
+func (p *WebhookPublisher) Publish(ctx context.Context, event *organization.OrganizationChanged) error {
+	body, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range p.headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("X-Org-Event-Id", strconv.FormatInt(event.EventId, 10))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// 读完响应体以便复用连接
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
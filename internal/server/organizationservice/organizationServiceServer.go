@@ -64,3 +64,57 @@ func (s *OrganizationServiceServer) GetDescendants(ctx context.Context, in *orga
 	l := organizationservicelogic.NewGetDescendantsLogic(ctx, s.svcCtx)
 	return l.GetDescendants(in)
 }
+
+// MoveOrganization 移动组织节点到新的父节点下
+func (s *OrganizationServiceServer) MoveOrganization(ctx context.Context, in *organization.MoveOrganizationRequest) (*organization.Organization, error) {
+	l := organizationservicelogic.NewMoveOrganizationLogic(ctx, s.svcCtx)
+	return l.MoveOrganization(in)
+}
+
+// DisableOrganization 禁用组织节点
+func (s *OrganizationServiceServer) DisableOrganization(ctx context.Context, in *organization.DisableOrganizationRequest) (*organization.Organization, error) {
+	l := organizationservicelogic.NewDisableOrganizationLogic(ctx, s.svcCtx)
+	return l.DisableOrganization(in)
+}
+
+// EnableOrganization 启用组织节点
+func (s *OrganizationServiceServer) EnableOrganization(ctx context.Context, in *organization.EnableOrganizationRequest) (*organization.Organization, error) {
+	l := organizationservicelogic.NewEnableOrganizationLogic(ctx, s.svcCtx)
+	return l.EnableOrganization(in)
+}
+
+// RestoreOrganization 恢复已删除的组织节点
+func (s *OrganizationServiceServer) RestoreOrganization(ctx context.Context, in *organization.RestoreOrganizationRequest) (*organization.Organization, error) {
+	l := organizationservicelogic.NewRestoreOrganizationLogic(ctx, s.svcCtx)
+	return l.RestoreOrganization(in)
+}
+
+// GetOrganizationByCode 按组织编码获取组织节点
+func (s *OrganizationServiceServer) GetOrganizationByCode(ctx context.Context, in *organization.GetOrganizationByCodeRequest) (*organization.Organization, error) {
+	l := organizationservicelogic.NewGetOrganizationByCodeLogic(ctx, s.svcCtx)
+	return l.GetOrganizationByCode(in)
+}
+
+// BatchGetOrganizationsByCode 按组织编码批量获取组织节点
+func (s *OrganizationServiceServer) BatchGetOrganizationsByCode(ctx context.Context, in *organization.BatchGetOrganizationsByCodeRequest) (*organization.BatchGetOrganizationsByCodeResponse, error) {
+	l := organizationservicelogic.NewBatchGetOrganizationsByCodeLogic(ctx, s.svcCtx)
+	return l.BatchGetOrganizationsByCode(in)
+}
+
+// ReorderChildren 按给定顺序重排子节点
+func (s *OrganizationServiceServer) ReorderChildren(ctx context.Context, in *organization.ReorderChildrenRequest) (*organization.ReorderChildrenResponse, error) {
+	l := organizationservicelogic.NewReorderChildrenLogic(ctx, s.svcCtx)
+	return l.ReorderChildren(in)
+}
+
+// ResolveDataScope ResolveDataScope 解析数据权限范围，返回可访问的组织 ID
+func (s *OrganizationServiceServer) ResolveDataScope(ctx context.Context, in *organization.ResolveDataScopeRequest) (*organization.ResolveDataScopeResponse, error) {
+	l := organizationservicelogic.NewResolveDataScopeLogic(ctx, s.svcCtx)
+	return l.ResolveDataScope(in)
+}
+
+// WatchOrganizations WatchOrganizations 订阅组织树变更，先推送子树快照，再按版本顺序推送变更事件
+func (s *OrganizationServiceServer) WatchOrganizations(in *organization.WatchOrganizationsRequest, stream organization.OrganizationService_WatchOrganizationsServer) error {
+	l := organizationservicelogic.NewWatchOrganizationsLogic(stream.Context(), s.svcCtx)
+	return l.WatchOrganizations(in, stream)
+}
@@ -4,20 +4,35 @@ import (
 	_ "github.com/lib/pq" // PostgreSQL driver
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/attribute"
 	"github.com/ziptako/organization/internal/config"
+	"github.com/ziptako/organization/internal/grant"
+	"github.com/ziptako/organization/internal/hierarchy"
+	"github.com/ziptako/organization/internal/naming"
 )
 
 type ServiceContext struct {
-	Config    config.Config
-	SqlConn   sqlx.SqlConn
-	CacheConf cache.CacheConf
+	Config          config.Config
+	SqlConn         sqlx.SqlConn
+	CacheConf       cache.CacheConf
+	NameNormalizer  *naming.Normalizer    // 组织名称规范化器
+	OrgTypeSchema   *hierarchy.Schema     // 组织类型层级规则
+	CodeGenerator   *naming.CodeGenerator // 组织编码生成器
+	AttributeSchema *attribute.Schema     // 组织扩展属性定义
+	GrantChecker    *grant.Checker        // 组织管理授权检查器
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	conn := sqlx.NewSqlConn("postgres", c.DataSource)
 	return &ServiceContext{
-		Config:    c,
-		SqlConn:   conn,
-		CacheConf: c.Cache, // 确保 CacheConf 被正确传递
+		Config:          c,
+		SqlConn:         conn,
+		CacheConf:       c.Cache, // 确保 CacheConf 被正确传递
+		NameNormalizer:  naming.NewNormalizer(c.NameNormalize),
+		OrgTypeSchema:   hierarchy.NewSchema(c.OrgType),
+		CodeGenerator:   naming.NewCodeGenerator(c.Code),
+		AttributeSchema: attribute.NewSchema(c.Attributes),
+		GrantChecker:    grant.NewChecker(model.NewAdminGrantsModel(conn, c.Cache), c.Auth.Enabled),
 	}
 }
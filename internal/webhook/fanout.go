@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/converter"
+	"github.com/ziptako/organization/organization"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Fanout 按订阅的过滤条件为发件箱事件创建投递记录，在发件箱投递器领取事件的事务中执行，与事件的发布相互独立
+type Fanout struct {
+	webhooks      model.WebhooksModel
+	organizations model.OrganizationsModel
+}
+
+// NewFanout 创建订阅分发
+func NewFanout(conn sqlx.SqlConn, c cache.CacheConf) *Fanout {
+	return &Fanout{
+		webhooks:      model.NewWebhooksModel(conn),
+		organizations: model.NewOrganizationsModel(conn, c),
+	}
+}
+
+// Deliveries 为一批事件在每个匹配的订阅下生成待投递记录，由 Worker 异步推送；订阅每批只加载一次
+// 载荷无法解析的事件不创建投递记录，由发件箱按失败重试直至转为死信
+func (f *Fanout) Deliveries(ctx context.Context, events []*model.Outbox) ([]*model.WebhookDeliveries, error) {
+	webhooks, err := f.webhooks.FindAll(ctx)
+	if err != nil || len(webhooks) == 0 {
+		return nil, err
+	}
+
+	within := func(rootId, id int64) (bool, error) {
+		if rootId == id {
+			return true, nil
+		}
+		return f.organizations.IsAncestor(ctx, rootId, id)
+	}
+	var deliveries []*model.WebhookDeliveries
+	for _, row := range events {
+		event, err := converter.ModelToProtoOrganizationChanged(row)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("fan out outbox event %d: %v", row.Id, err)
+			continue
+		}
+		var body []byte
+		for _, w := range webhooks {
+			ok, err := matches(w, event, within)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			if body == nil {
+				if body, err = protojson.Marshal(event); err != nil {
+					return nil, err
+				}
+			}
+			deliveries = append(deliveries, &model.WebhookDeliveries{
+				WebhookId: w.Id,
+				EventId:   row.Id,
+				EventType: row.EventType,
+				Payload:   string(body),
+			})
+		}
+	}
+	return deliveries, nil
+}
+
+// matches 判断事件是否符合订阅的变更类型与子树过滤条件
+// 组织的物化路径包含订阅的根节点即在子树内；移动事件中原父节点在子树内（移出子树）同样匹配，由 within 按当前树结构判断
+func matches(w *model.Webhooks, event *organization.OrganizationChanged, within func(rootId, id int64) (bool, error)) (bool, error) {
+	if len(w.EventTypes) > 0 {
+		eventType := strings.ToLower(strings.TrimPrefix(event.Type.String(), "CHANGE_TYPE_"))
+		if !slices.Contains(w.EventTypes, eventType) {
+			return false, nil
+		}
+	}
+	if !w.RootOrganizationId.Valid {
+		return true, nil
+	}
+
+	rootId := w.RootOrganizationId.Int64
+	if strings.Contains(event.Organization.GetPath(), "/"+strconv.FormatInt(rootId, 10)+"/") {
+		return true, nil
+	}
+	if event.Type == organization.ChangeType_CHANGE_TYPE_MOVED && event.PreviousParentId != 0 {
+		return within(rootId, event.PreviousParentId)
+	}
+	return false, nil
+}
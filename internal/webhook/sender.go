@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// 推送请求头
+const (
+	HeaderEventId   = "X-Org-Event-Id"  // 事件 ID，供接收方去重
+	HeaderTimestamp = "X-Org-Timestamp" // 发送时间（Unix 秒）
+	HeaderSignature = "X-Org-Signature" // 请求签名，sha256=<hex>
+)
+
+// signaturePrefix 签名的算法前缀
+const signaturePrefix = "sha256="
+
+// Sign 以 secret 为密钥对 "<timestamp>.<body>" 计算 HMAC-SHA256，返回 sha256=<hex>
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 校验请求签名，供接收方使用；调用方还应检查 timestamp 与当前时间的差值以防重放
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Sender 签名并推送事件
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender 创建推送器，timeout 为单次推送超时
+func NewSender(timeout time.Duration) *Sender {
+	return &Sender{
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Send 将 body POST 到 url，返回响应状态码；未收到响应时状态码为 0，非 2xx 响应视为失败
+func (s *Sender) Send(ctx context.Context, url, secret string, eventId int64, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventId, strconv.FormatInt(eventId, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// 读完响应体以便复用连接
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,122 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/config"
+	"github.com/ziptako/organization/organization"
+)
+
+func TestSenderSignsRequest(t *testing.T) {
+	const secret = "0123456789abcdef"
+	body := []byte(`{"eventId":"42"}`)
+
+	var got *http.Request
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	statusCode, err := NewSender(time.Second).Send(context.Background(), srv.URL, secret, 42, body)
+	if err != nil || statusCode != http.StatusOK {
+		t.Fatalf("Send = %d, %v", statusCode, err)
+	}
+	if got.Header.Get(HeaderEventId) != "42" {
+		t.Errorf("event id header = %q", got.Header.Get(HeaderEventId))
+	}
+	timestamp, err := strconv.ParseInt(got.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("timestamp header: %v", err)
+	}
+	if !Verify(secret, timestamp, gotBody, got.Header.Get(HeaderSignature)) {
+		t.Errorf("signature %q does not verify", got.Header.Get(HeaderSignature))
+	}
+	if Verify("another-secret-value", timestamp, gotBody, got.Header.Get(HeaderSignature)) {
+		t.Error("signature verifies with a different secret")
+	}
+}
+
+func TestSenderRejectsNon2xx(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	statusCode, err := NewSender(time.Second).Send(context.Background(), srv.URL, "0123456789abcdef", 1, []byte("{}"))
+	if err == nil || statusCode != http.StatusInternalServerError {
+		t.Fatalf("Send = %d, %v; want 500 and an error", statusCode, err)
+	}
+}
+
+func TestOutcome(t *testing.T) {
+	c := config.WebhookDeliveryConf{MinBackoff: 10 * time.Second, MaxBackoff: time.Minute, MaxAttempts: 5}
+	now := time.Unix(1_700_000_000, 0)
+	failed := io.ErrUnexpectedEOF
+
+	tests := []struct {
+		attempt int64
+		err     error
+		status  string
+		wait    time.Duration
+	}{
+		{1, nil, model.DeliveryDelivered, 0},
+		{1, failed, model.DeliveryPending, 10 * time.Second},
+		{2, failed, model.DeliveryPending, 20 * time.Second},
+		{3, failed, model.DeliveryPending, 40 * time.Second},
+		{4, failed, model.DeliveryPending, time.Minute},
+		{5, failed, model.DeliveryDead, 0},
+	}
+	for _, tt := range tests {
+		status, retryAt := outcome(c, tt.attempt, tt.err, now)
+		if status != tt.status || retryAt.Sub(now) != tt.wait {
+			t.Errorf("outcome(%d, %v) = %s, %v; want %s, %v", tt.attempt, tt.err, status, retryAt.Sub(now), tt.status, tt.wait)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	// 组织 5 位于 /1/5/ 下，组织 9 在子树外
+	within := func(rootId, id int64) (bool, error) {
+		return rootId == 1 && id == 5, nil
+	}
+	event := func(changeType organization.ChangeType, path string, previousParentId int64) *organization.OrganizationChanged {
+		return &organization.OrganizationChanged{
+			Type:             changeType,
+			Organization:     &organization.Organization{Path: path},
+			PreviousParentId: previousParentId,
+		}
+	}
+	root := sql.NullInt64{Valid: true, Int64: 1}
+
+	tests := []struct {
+		name    string
+		webhook *model.Webhooks
+		event   *organization.OrganizationChanged
+		want    bool
+	}{
+		{"all", &model.Webhooks{}, event(organization.ChangeType_CHANGE_TYPE_UPDATED, "/9/", 0), true},
+		{"type matched", &model.Webhooks{EventTypes: []string{"updated"}}, event(organization.ChangeType_CHANGE_TYPE_UPDATED, "/9/", 0), true},
+		{"type filtered", &model.Webhooks{EventTypes: []string{"created"}}, event(organization.ChangeType_CHANGE_TYPE_UPDATED, "/9/", 0), false},
+		{"in subtree", &model.Webhooks{RootOrganizationId: root}, event(organization.ChangeType_CHANGE_TYPE_UPDATED, "/1/5/12/", 0), true},
+		{"root itself", &model.Webhooks{RootOrganizationId: root}, event(organization.ChangeType_CHANGE_TYPE_UPDATED, "/1/", 0), true},
+		{"id prefix", &model.Webhooks{RootOrganizationId: root}, event(organization.ChangeType_CHANGE_TYPE_UPDATED, "/11/", 0), false},
+		{"moved out", &model.Webhooks{RootOrganizationId: root}, event(organization.ChangeType_CHANGE_TYPE_MOVED, "/9/12/", 5), true},
+		{"moved elsewhere", &model.Webhooks{RootOrganizationId: root}, event(organization.ChangeType_CHANGE_TYPE_MOVED, "/9/12/", 9), false},
+	}
+	for _, tt := range tests {
+		got, err := matches(tt.webhook, tt.event, within)
+		if err != nil || got != tt.want {
+			t.Errorf("%s: matches = %v, %v; want %v", tt.name, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/threading"
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/config"
+)
+
+// Worker 后台投递协程，领取到期的投递记录并推送到订阅地址
+// 失败的投递按指数退避重试，尝试 MaxAttempts 次后转为死信；多实例部署时各实例领取不同的记录
+type Worker struct {
+	c          config.WebhookDeliveryConf
+	webhooks   model.WebhooksModel
+	deliveries model.WebhookDeliveriesModel
+	sender     *Sender
+	done       chan struct{}
+	stopped    chan struct{}
+}
+
+// NewWorker 创建投递协程
+func NewWorker(c config.WebhookDeliveryConf, conn sqlx.SqlConn) *Worker {
+	return &Worker{
+		c:          c,
+		webhooks:   model.NewWebhooksModel(conn),
+		deliveries: model.NewWebhookDeliveriesModel(conn),
+		sender:     NewSender(c.Timeout),
+		done:       make(chan struct{}),
+		stopped:    make(chan struct{}),
+	}
+}
+
+// Start 开始投递，阻塞直到 Stop 被调用
+func (w *Worker) Start() {
+	defer close(w.stopped)
+
+	ticker := time.NewTicker(w.c.Interval)
+	defer ticker.Stop()
+	for {
+		w.deliverDue()
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop 停止投递，等待进行中的一批完成
+func (w *Worker) Stop() {
+	close(w.done)
+	<-w.stopped
+}
+
+// deliverDue 连续领取并投递，直到没有到期的记录或收到停止信号
+func (w *Worker) deliverDue() {
+	ctx := context.Background()
+	// 领取的记录在租期内不会被其他实例重复领取，租期需覆盖一批并发推送的耗时
+	lease := max(2*w.c.Timeout, time.Minute)
+	for {
+		deliveries, err := w.deliveries.ClaimDue(ctx, w.c.Concurrency, lease)
+		if err != nil {
+			logx.Errorf("claim webhook deliveries: %v", err)
+			return
+		}
+
+		group := threading.NewRoutineGroup()
+		for _, delivery := range deliveries {
+			group.RunSafe(func() {
+				w.deliver(ctx, delivery)
+			})
+		}
+		group.Wait()
+
+		if len(deliveries) < w.c.Concurrency {
+			return
+		}
+		select {
+		case <-w.done:
+			return
+		default:
+		}
+	}
+}
+
+// deliver 推送一条投递并记录结果
+func (w *Worker) deliver(ctx context.Context, delivery *model.WebhookDeliveries) {
+	webhook, err := w.webhooks.FindOne(ctx, delivery.WebhookId)
+	if err != nil {
+		// 订阅已删除时其投递记录随之级联删除
+		if !errors.Is(err, model.ErrNotFound) {
+			logx.Errorf("find webhook %d: %v", delivery.WebhookId, err)
+		}
+		return
+	}
+
+	start := time.Now()
+	statusCode, err := w.sender.Send(ctx, webhook.Url, webhook.Secret, delivery.EventId, []byte(delivery.Payload))
+	attempt := &model.WebhookAttempts{
+		DeliveryId: delivery.Id,
+		WebhookId:  delivery.WebhookId,
+		Attempt:    delivery.Attempts + 1,
+		StatusCode: int64(statusCode),
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		attempt.Error = sql.NullString{Valid: true, String: err.Error()}
+		logx.Infof("deliver event %d to webhook %d (attempt %d): %v", delivery.EventId, delivery.WebhookId, attempt.Attempt, err)
+	}
+
+	var retryAt time.Time
+	delivery.Status, retryAt = outcome(w.c, attempt.Attempt, err, time.Now())
+	if err := w.deliveries.RecordAttempt(ctx, delivery, attempt, retryAt); err != nil {
+		logx.Errorf("record webhook attempt for delivery %d: %v", delivery.Id, err)
+	}
+}
+
+// outcome 第 attempt 次尝试后的投递状态及下次投递时间：成功为已投递，失败时按 MinBackoff 逐次翻倍、不超过 MaxBackoff 推迟重试，
+// 达到 MaxAttempts 次后转为死信
+func outcome(c config.WebhookDeliveryConf, attempt int64, err error, now time.Time) (string, time.Time) {
+	switch {
+	case err == nil:
+		return model.DeliveryDelivered, now
+	case attempt >= c.MaxAttempts:
+		return model.DeliveryDead, now
+	}
+	wait := c.MinBackoff
+	for i := int64(1); i < attempt && wait < c.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return model.DeliveryPending, now.Add(min(wait, c.MaxBackoff))
+}
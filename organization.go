@@ -1,22 +1,38 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 
+	"github.com/ziptako/organization/db/model"
+	"github.com/ziptako/organization/internal/audit"
+	"github.com/ziptako/organization/internal/auth"
 	"github.com/ziptako/organization/internal/config"
+	"github.com/ziptako/organization/internal/outbox"
+	admingrantserviceServer "github.com/ziptako/organization/internal/server/admingrantservice"
+	auditserviceServer "github.com/ziptako/organization/internal/server/auditservice"
+	membershipserviceServer "github.com/ziptako/organization/internal/server/membershipservice"
 	organizationserviceServer "github.com/ziptako/organization/internal/server/organizationservice"
+	positionserviceServer "github.com/ziptako/organization/internal/server/positionservice"
+	webhookserviceServer "github.com/ziptako/organization/internal/server/webhookservice"
 	"github.com/ziptako/organization/internal/svc"
+	"github.com/ziptako/organization/internal/webhook"
 	"github.com/ziptako/organization/organization"
 
 	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/service"
 	"github.com/zeromicro/go-zero/zrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
-var configFile = flag.String("f", "etc/organization.yaml", "the config file")
+var (
+	configFile     = flag.String("f", "etc/organization.yaml", "the config file")
+	rebuildClosure = flag.Bool("rebuild-closure", false, "rebuild org.organization_closure from parent_id and exit")
+	rebuildNameKey = flag.Bool("rebuild-name-keys", false, "regenerate org.organizations.name_key with the NameNormalize rules and exit")
+)
 
 func main() {
 	flag.Parse()
@@ -25,15 +41,54 @@ func main() {
 	conf.MustLoad(*configFile, &c)
 	ctx := svc.NewServiceContext(c)
 
+	if *rebuildClosure {
+		m := model.NewOrganizationsModel(ctx.SqlConn, ctx.CacheConf)
+		logx.Must(m.RebuildClosure(context.Background()))
+		logx.Info("organization closure rebuilt")
+		return
+	}
+	if *rebuildNameKey {
+		m := model.NewOrganizationsModel(ctx.SqlConn, ctx.CacheConf)
+		n, err := m.RebuildNameKeys(context.Background(), ctx.NameNormalizer.Normalize)
+		logx.Must(err)
+		logx.Infof("organization name keys rebuilt, %d changed", n)
+		return
+	}
+
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		organization.RegisterOrganizationServiceServer(grpcServer, organizationserviceServer.NewOrganizationServiceServer(ctx))
+		organization.RegisterMembershipServiceServer(grpcServer, membershipserviceServer.NewMembershipServiceServer(ctx))
+		organization.RegisterPositionServiceServer(grpcServer, positionserviceServer.NewPositionServiceServer(ctx))
+		organization.RegisterAdminGrantServiceServer(grpcServer, admingrantserviceServer.NewAdminGrantServiceServer(ctx))
+		organization.RegisterWebhookServiceServer(grpcServer, webhookserviceServer.NewWebhookServiceServer(ctx))
+		organization.RegisterAuditServiceServer(grpcServer, auditserviceServer.NewAuditServiceServer(ctx))
 
 		if c.Mode == service.DevMode || c.Mode == service.TestMode {
 			reflection.Register(grpcServer)
 		}
 	})
+	if c.Auth.Enabled {
+		s.AddUnaryInterceptors(auth.MustUnaryInterceptors(c.Auth)...)
+		s.AddStreamInterceptors(auth.MustStreamInterceptors(c.Auth)...)
+	}
+	// 审计拦截器读取认证得到的调用方，需在认证拦截器之后
+	s.AddUnaryInterceptors(audit.UnaryInterceptor)
 	defer s.Stop()
 
+	if c.Outbox.Enabled {
+		// Webhook 订阅的投递记录由发件箱投递器创建，因此仅在启用发件箱时生效
+		var fanout model.FanoutFunc
+		if c.Webhooks.Enabled {
+			worker := webhook.NewWorker(c.Webhooks, ctx.SqlConn)
+			go worker.Start()
+			defer worker.Stop()
+			fanout = webhook.NewFanout(ctx.SqlConn, ctx.CacheConf).Deliveries
+		}
+		dispatcher := outbox.MustNewDispatcher(c.Outbox, ctx.SqlConn, fanout)
+		go dispatcher.Start()
+		defer dispatcher.Stop()
+	}
+
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
 	s.Start()
 }
@@ -19,13 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrganizationService_CreateOrganization_FullMethodName = "/organization.organizationService/CreateOrganization"
-	OrganizationService_GetOrganization_FullMethodName    = "/organization.organizationService/GetOrganization"
-	OrganizationService_UpdateOrganization_FullMethodName = "/organization.organizationService/UpdateOrganization"
-	OrganizationService_DeleteOrganization_FullMethodName = "/organization.organizationService/DeleteOrganization"
-	OrganizationService_ListOrganizations_FullMethodName  = "/organization.organizationService/ListOrganizations"
-	OrganizationService_GetAncestors_FullMethodName       = "/organization.organizationService/GetAncestors"
-	OrganizationService_GetDescendants_FullMethodName     = "/organization.organizationService/GetDescendants"
+	OrganizationService_CreateOrganization_FullMethodName          = "/organization.organizationService/CreateOrganization"
+	OrganizationService_GetOrganization_FullMethodName             = "/organization.organizationService/GetOrganization"
+	OrganizationService_UpdateOrganization_FullMethodName          = "/organization.organizationService/UpdateOrganization"
+	OrganizationService_DeleteOrganization_FullMethodName          = "/organization.organizationService/DeleteOrganization"
+	OrganizationService_ListOrganizations_FullMethodName           = "/organization.organizationService/ListOrganizations"
+	OrganizationService_GetAncestors_FullMethodName                = "/organization.organizationService/GetAncestors"
+	OrganizationService_GetDescendants_FullMethodName              = "/organization.organizationService/GetDescendants"
+	OrganizationService_MoveOrganization_FullMethodName            = "/organization.organizationService/MoveOrganization"
+	OrganizationService_DisableOrganization_FullMethodName         = "/organization.organizationService/DisableOrganization"
+	OrganizationService_EnableOrganization_FullMethodName          = "/organization.organizationService/EnableOrganization"
+	OrganizationService_RestoreOrganization_FullMethodName         = "/organization.organizationService/RestoreOrganization"
+	OrganizationService_GetOrganizationByCode_FullMethodName       = "/organization.organizationService/GetOrganizationByCode"
+	OrganizationService_BatchGetOrganizationsByCode_FullMethodName = "/organization.organizationService/BatchGetOrganizationsByCode"
+	OrganizationService_ReorderChildren_FullMethodName             = "/organization.organizationService/ReorderChildren"
+	OrganizationService_ResolveDataScope_FullMethodName            = "/organization.organizationService/ResolveDataScope"
+	OrganizationService_WatchOrganizations_FullMethodName          = "/organization.organizationService/WatchOrganizations"
 )
 
 // OrganizationServiceClient is the client API for OrganizationService service.
@@ -51,6 +60,24 @@ type OrganizationServiceClient interface {
 	GetAncestors(ctx context.Context, in *GetAncestorsRequest, opts ...grpc.CallOption) (*GetAncestorsResponse, error)
 	// GetDescendants 获取后代树
 	GetDescendants(ctx context.Context, in *GetDescendantsRequest, opts ...grpc.CallOption) (*GetDescendantsResponse, error)
+	// MoveOrganization 移动组织节点到新的父节点下
+	MoveOrganization(ctx context.Context, in *MoveOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	// DisableOrganization 禁用组织节点
+	DisableOrganization(ctx context.Context, in *DisableOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	// EnableOrganization 启用组织节点
+	EnableOrganization(ctx context.Context, in *EnableOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	// RestoreOrganization 恢复已删除的组织节点
+	RestoreOrganization(ctx context.Context, in *RestoreOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	// GetOrganizationByCode 按组织编码获取组织节点
+	GetOrganizationByCode(ctx context.Context, in *GetOrganizationByCodeRequest, opts ...grpc.CallOption) (*Organization, error)
+	// BatchGetOrganizationsByCode 按组织编码批量获取组织节点
+	BatchGetOrganizationsByCode(ctx context.Context, in *BatchGetOrganizationsByCodeRequest, opts ...grpc.CallOption) (*BatchGetOrganizationsByCodeResponse, error)
+	// ReorderChildren 按给定顺序重排子节点
+	ReorderChildren(ctx context.Context, in *ReorderChildrenRequest, opts ...grpc.CallOption) (*ReorderChildrenResponse, error)
+	// ResolveDataScope 解析数据权限范围，返回可访问的组织 ID
+	ResolveDataScope(ctx context.Context, in *ResolveDataScopeRequest, opts ...grpc.CallOption) (*ResolveDataScopeResponse, error)
+	// WatchOrganizations 订阅组织树变更，先推送子树快照，再按版本顺序推送变更事件
+	WatchOrganizations(ctx context.Context, in *WatchOrganizationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchOrganizationsResponse], error)
 }
 
 type organizationServiceClient struct {
@@ -131,6 +158,105 @@ func (c *organizationServiceClient) GetDescendants(ctx context.Context, in *GetD
 	return out, nil
 }
 
+func (c *organizationServiceClient) MoveOrganization(ctx context.Context, in *MoveOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, OrganizationService_MoveOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) DisableOrganization(ctx context.Context, in *DisableOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, OrganizationService_DisableOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) EnableOrganization(ctx context.Context, in *EnableOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, OrganizationService_EnableOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) RestoreOrganization(ctx context.Context, in *RestoreOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, OrganizationService_RestoreOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetOrganizationByCode(ctx context.Context, in *GetOrganizationByCodeRequest, opts ...grpc.CallOption) (*Organization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, OrganizationService_GetOrganizationByCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) BatchGetOrganizationsByCode(ctx context.Context, in *BatchGetOrganizationsByCodeRequest, opts ...grpc.CallOption) (*BatchGetOrganizationsByCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetOrganizationsByCodeResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_BatchGetOrganizationsByCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ReorderChildren(ctx context.Context, in *ReorderChildrenRequest, opts ...grpc.CallOption) (*ReorderChildrenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderChildrenResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ReorderChildren_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ResolveDataScope(ctx context.Context, in *ResolveDataScopeRequest, opts ...grpc.CallOption) (*ResolveDataScopeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveDataScopeResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ResolveDataScope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) WatchOrganizations(ctx context.Context, in *WatchOrganizationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchOrganizationsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrganizationService_ServiceDesc.Streams[0], OrganizationService_WatchOrganizations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchOrganizationsRequest, WatchOrganizationsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrganizationService_WatchOrganizationsClient = grpc.ServerStreamingClient[WatchOrganizationsResponse]
+
 // OrganizationServiceServer is the server API for OrganizationService service.
 // All implementations must embed UnimplementedOrganizationServiceServer
 // for forward compatibility.
@@ -154,6 +280,24 @@ type OrganizationServiceServer interface {
 	GetAncestors(context.Context, *GetAncestorsRequest) (*GetAncestorsResponse, error)
 	// GetDescendants 获取后代树
 	GetDescendants(context.Context, *GetDescendantsRequest) (*GetDescendantsResponse, error)
+	// MoveOrganization 移动组织节点到新的父节点下
+	MoveOrganization(context.Context, *MoveOrganizationRequest) (*Organization, error)
+	// DisableOrganization 禁用组织节点
+	DisableOrganization(context.Context, *DisableOrganizationRequest) (*Organization, error)
+	// EnableOrganization 启用组织节点
+	EnableOrganization(context.Context, *EnableOrganizationRequest) (*Organization, error)
+	// RestoreOrganization 恢复已删除的组织节点
+	RestoreOrganization(context.Context, *RestoreOrganizationRequest) (*Organization, error)
+	// GetOrganizationByCode 按组织编码获取组织节点
+	GetOrganizationByCode(context.Context, *GetOrganizationByCodeRequest) (*Organization, error)
+	// BatchGetOrganizationsByCode 按组织编码批量获取组织节点
+	BatchGetOrganizationsByCode(context.Context, *BatchGetOrganizationsByCodeRequest) (*BatchGetOrganizationsByCodeResponse, error)
+	// ReorderChildren 按给定顺序重排子节点
+	ReorderChildren(context.Context, *ReorderChildrenRequest) (*ReorderChildrenResponse, error)
+	// ResolveDataScope 解析数据权限范围，返回可访问的组织 ID
+	ResolveDataScope(context.Context, *ResolveDataScopeRequest) (*ResolveDataScopeResponse, error)
+	// WatchOrganizations 订阅组织树变更，先推送子树快照，再按版本顺序推送变更事件
+	WatchOrganizations(*WatchOrganizationsRequest, grpc.ServerStreamingServer[WatchOrganizationsResponse]) error
 	mustEmbedUnimplementedOrganizationServiceServer()
 }
 
@@ -185,6 +329,33 @@ func (UnimplementedOrganizationServiceServer) GetAncestors(context.Context, *Get
 func (UnimplementedOrganizationServiceServer) GetDescendants(context.Context, *GetDescendantsRequest) (*GetDescendantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDescendants not implemented")
 }
+func (UnimplementedOrganizationServiceServer) MoveOrganization(context.Context, *MoveOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveOrganization not implemented")
+}
+func (UnimplementedOrganizationServiceServer) DisableOrganization(context.Context, *DisableOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableOrganization not implemented")
+}
+func (UnimplementedOrganizationServiceServer) EnableOrganization(context.Context, *EnableOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableOrganization not implemented")
+}
+func (UnimplementedOrganizationServiceServer) RestoreOrganization(context.Context, *RestoreOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreOrganization not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetOrganizationByCode(context.Context, *GetOrganizationByCodeRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationByCode not implemented")
+}
+func (UnimplementedOrganizationServiceServer) BatchGetOrganizationsByCode(context.Context, *BatchGetOrganizationsByCodeRequest) (*BatchGetOrganizationsByCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetOrganizationsByCode not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ReorderChildren(context.Context, *ReorderChildrenRequest) (*ReorderChildrenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderChildren not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ResolveDataScope(context.Context, *ResolveDataScopeRequest) (*ResolveDataScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDataScope not implemented")
+}
+func (UnimplementedOrganizationServiceServer) WatchOrganizations(*WatchOrganizationsRequest, grpc.ServerStreamingServer[WatchOrganizationsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrganizations not implemented")
+}
 func (UnimplementedOrganizationServiceServer) mustEmbedUnimplementedOrganizationServiceServer() {}
 func (UnimplementedOrganizationServiceServer) testEmbeddedByValue()                             {}
 
@@ -332,6 +503,161 @@ func _OrganizationService_GetDescendants_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrganizationService_MoveOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).MoveOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_MoveOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).MoveOrganization(ctx, req.(*MoveOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_DisableOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).DisableOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_DisableOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).DisableOrganization(ctx, req.(*DisableOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_EnableOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).EnableOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_EnableOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).EnableOrganization(ctx, req.(*EnableOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_RestoreOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).RestoreOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_RestoreOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).RestoreOrganization(ctx, req.(*RestoreOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetOrganizationByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationByCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetOrganizationByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetOrganizationByCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetOrganizationByCode(ctx, req.(*GetOrganizationByCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_BatchGetOrganizationsByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetOrganizationsByCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).BatchGetOrganizationsByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_BatchGetOrganizationsByCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).BatchGetOrganizationsByCode(ctx, req.(*BatchGetOrganizationsByCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ReorderChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ReorderChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ReorderChildren_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ReorderChildren(ctx, req.(*ReorderChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ResolveDataScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveDataScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ResolveDataScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ResolveDataScope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ResolveDataScope(ctx, req.(*ResolveDataScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_WatchOrganizations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrganizationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrganizationServiceServer).WatchOrganizations(m, &grpc.GenericServerStream[WatchOrganizationsRequest, WatchOrganizationsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrganizationService_WatchOrganizationsServer = grpc.ServerStreamingServer[WatchOrganizationsResponse]
+
 // OrganizationService_ServiceDesc is the grpc.ServiceDesc for OrganizationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -367,6 +693,1094 @@ var OrganizationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDescendants",
 			Handler:    _OrganizationService_GetDescendants_Handler,
 		},
+		{
+			MethodName: "MoveOrganization",
+			Handler:    _OrganizationService_MoveOrganization_Handler,
+		},
+		{
+			MethodName: "DisableOrganization",
+			Handler:    _OrganizationService_DisableOrganization_Handler,
+		},
+		{
+			MethodName: "EnableOrganization",
+			Handler:    _OrganizationService_EnableOrganization_Handler,
+		},
+		{
+			MethodName: "RestoreOrganization",
+			Handler:    _OrganizationService_RestoreOrganization_Handler,
+		},
+		{
+			MethodName: "GetOrganizationByCode",
+			Handler:    _OrganizationService_GetOrganizationByCode_Handler,
+		},
+		{
+			MethodName: "BatchGetOrganizationsByCode",
+			Handler:    _OrganizationService_BatchGetOrganizationsByCode_Handler,
+		},
+		{
+			MethodName: "ReorderChildren",
+			Handler:    _OrganizationService_ReorderChildren_Handler,
+		},
+		{
+			MethodName: "ResolveDataScope",
+			Handler:    _OrganizationService_ResolveDataScope_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrganizations",
+			Handler:       _OrganizationService_WatchOrganizations_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "organization.proto",
+}
+
+const (
+	MembershipService_AddMember_FullMethodName               = "/organization.membershipService/AddMember"
+	MembershipService_RemoveMember_FullMethodName            = "/organization.membershipService/RemoveMember"
+	MembershipService_ListMembers_FullMethodName             = "/organization.membershipService/ListMembers"
+	MembershipService_ListOrganizationsOfUser_FullMethodName = "/organization.membershipService/ListOrganizationsOfUser"
+)
+
+// MembershipServiceClient is the client API for MembershipService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============================================================
+// membershipService
+// 组织成员（用户与组织的归属关系）服务
+// ============================================================
+type MembershipServiceClient interface {
+	// AddMember 添加组织成员
+	AddMember(ctx context.Context, in *AddMemberRequest, opts ...grpc.CallOption) (*Membership, error)
+	// RemoveMember 移除组织成员
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
+	// ListMembers 分页查询组织成员
+	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	// ListOrganizationsOfUser 查询用户所属的组织
+	ListOrganizationsOfUser(ctx context.Context, in *ListOrganizationsOfUserRequest, opts ...grpc.CallOption) (*ListOrganizationsOfUserResponse, error)
+}
+
+type membershipServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMembershipServiceClient(cc grpc.ClientConnInterface) MembershipServiceClient {
+	return &membershipServiceClient{cc}
+}
+
+func (c *membershipServiceClient) AddMember(ctx context.Context, in *AddMemberRequest, opts ...grpc.CallOption) (*Membership, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Membership)
+	err := c.cc.Invoke(ctx, MembershipService_AddMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *membershipServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMemberResponse)
+	err := c.cc.Invoke(ctx, MembershipService_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *membershipServiceClient) ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMembersResponse)
+	err := c.cc.Invoke(ctx, MembershipService_ListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *membershipServiceClient) ListOrganizationsOfUser(ctx context.Context, in *ListOrganizationsOfUserRequest, opts ...grpc.CallOption) (*ListOrganizationsOfUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganizationsOfUserResponse)
+	err := c.cc.Invoke(ctx, MembershipService_ListOrganizationsOfUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MembershipServiceServer is the server API for MembershipService service.
+// All implementations must embed UnimplementedMembershipServiceServer
+// for forward compatibility.
+//
+// ============================================================
+// membershipService
+// 组织成员（用户与组织的归属关系）服务
+// ============================================================
+type MembershipServiceServer interface {
+	// AddMember 添加组织成员
+	AddMember(context.Context, *AddMemberRequest) (*Membership, error)
+	// RemoveMember 移除组织成员
+	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
+	// ListMembers 分页查询组织成员
+	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	// ListOrganizationsOfUser 查询用户所属的组织
+	ListOrganizationsOfUser(context.Context, *ListOrganizationsOfUserRequest) (*ListOrganizationsOfUserResponse, error)
+	mustEmbedUnimplementedMembershipServiceServer()
+}
+
+// UnimplementedMembershipServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMembershipServiceServer struct{}
+
+func (UnimplementedMembershipServiceServer) AddMember(context.Context, *AddMemberRequest) (*Membership, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMember not implemented")
+}
+func (UnimplementedMembershipServiceServer) RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedMembershipServiceServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedMembershipServiceServer) ListOrganizationsOfUser(context.Context, *ListOrganizationsOfUserRequest) (*ListOrganizationsOfUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationsOfUser not implemented")
+}
+func (UnimplementedMembershipServiceServer) mustEmbedUnimplementedMembershipServiceServer() {}
+func (UnimplementedMembershipServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeMembershipServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MembershipServiceServer will
+// result in compilation errors.
+type UnsafeMembershipServiceServer interface {
+	mustEmbedUnimplementedMembershipServiceServer()
+}
+
+func RegisterMembershipServiceServer(s grpc.ServiceRegistrar, srv MembershipServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMembershipServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MembershipService_ServiceDesc, srv)
+}
+
+func _MembershipService_AddMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MembershipServiceServer).AddMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MembershipService_AddMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MembershipServiceServer).AddMember(ctx, req.(*AddMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MembershipService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MembershipServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MembershipService_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MembershipServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MembershipService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MembershipServiceServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MembershipService_ListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MembershipServiceServer).ListMembers(ctx, req.(*ListMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MembershipService_ListOrganizationsOfUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationsOfUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MembershipServiceServer).ListOrganizationsOfUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MembershipService_ListOrganizationsOfUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MembershipServiceServer).ListOrganizationsOfUser(ctx, req.(*ListOrganizationsOfUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MembershipService_ServiceDesc is the grpc.ServiceDesc for MembershipService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MembershipService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "organization.membershipService",
+	HandlerType: (*MembershipServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddMember",
+			Handler:    _MembershipService_AddMember_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _MembershipService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _MembershipService_ListMembers_Handler,
+		},
+		{
+			MethodName: "ListOrganizationsOfUser",
+			Handler:    _MembershipService_ListOrganizationsOfUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "organization.proto",
+}
+
+const (
+	PositionService_CreatePosition_FullMethodName  = "/organization.positionService/CreatePosition"
+	PositionService_AssignPosition_FullMethodName  = "/organization.positionService/AssignPosition"
+	PositionService_DeletePosition_FullMethodName  = "/organization.positionService/DeletePosition"
+	PositionService_ListPositions_FullMethodName   = "/organization.positionService/ListPositions"
+	PositionService_GetManagerChain_FullMethodName = "/organization.positionService/GetManagerChain"
+)
+
+// PositionServiceClient is the client API for PositionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============================================================
+// positionService
+// 组织职位（负责人、副职等）及汇报线服务
+// ============================================================
+type PositionServiceClient interface {
+	// CreatePosition 创建组织职位
+	CreatePosition(ctx context.Context, in *CreatePositionRequest, opts ...grpc.CallOption) (*Position, error)
+	// AssignPosition 任命或免去职位的任职用户
+	AssignPosition(ctx context.Context, in *AssignPositionRequest, opts ...grpc.CallOption) (*Position, error)
+	// DeletePosition 删除组织职位
+	DeletePosition(ctx context.Context, in *DeletePositionRequest, opts ...grpc.CallOption) (*DeletePositionResponse, error)
+	// ListPositions 查询组织的全部职位
+	ListPositions(ctx context.Context, in *ListPositionsRequest, opts ...grpc.CallOption) (*ListPositionsResponse, error)
+	// GetManagerChain 获取逐级的负责人链
+	GetManagerChain(ctx context.Context, in *GetManagerChainRequest, opts ...grpc.CallOption) (*GetManagerChainResponse, error)
+}
+
+type positionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPositionServiceClient(cc grpc.ClientConnInterface) PositionServiceClient {
+	return &positionServiceClient{cc}
+}
+
+func (c *positionServiceClient) CreatePosition(ctx context.Context, in *CreatePositionRequest, opts ...grpc.CallOption) (*Position, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Position)
+	err := c.cc.Invoke(ctx, PositionService_CreatePosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *positionServiceClient) AssignPosition(ctx context.Context, in *AssignPositionRequest, opts ...grpc.CallOption) (*Position, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Position)
+	err := c.cc.Invoke(ctx, PositionService_AssignPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *positionServiceClient) DeletePosition(ctx context.Context, in *DeletePositionRequest, opts ...grpc.CallOption) (*DeletePositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePositionResponse)
+	err := c.cc.Invoke(ctx, PositionService_DeletePosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *positionServiceClient) ListPositions(ctx context.Context, in *ListPositionsRequest, opts ...grpc.CallOption) (*ListPositionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPositionsResponse)
+	err := c.cc.Invoke(ctx, PositionService_ListPositions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *positionServiceClient) GetManagerChain(ctx context.Context, in *GetManagerChainRequest, opts ...grpc.CallOption) (*GetManagerChainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetManagerChainResponse)
+	err := c.cc.Invoke(ctx, PositionService_GetManagerChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PositionServiceServer is the server API for PositionService service.
+// All implementations must embed UnimplementedPositionServiceServer
+// for forward compatibility.
+//
+// ============================================================
+// positionService
+// 组织职位（负责人、副职等）及汇报线服务
+// ============================================================
+type PositionServiceServer interface {
+	// CreatePosition 创建组织职位
+	CreatePosition(context.Context, *CreatePositionRequest) (*Position, error)
+	// AssignPosition 任命或免去职位的任职用户
+	AssignPosition(context.Context, *AssignPositionRequest) (*Position, error)
+	// DeletePosition 删除组织职位
+	DeletePosition(context.Context, *DeletePositionRequest) (*DeletePositionResponse, error)
+	// ListPositions 查询组织的全部职位
+	ListPositions(context.Context, *ListPositionsRequest) (*ListPositionsResponse, error)
+	// GetManagerChain 获取逐级的负责人链
+	GetManagerChain(context.Context, *GetManagerChainRequest) (*GetManagerChainResponse, error)
+	mustEmbedUnimplementedPositionServiceServer()
+}
+
+// UnimplementedPositionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPositionServiceServer struct{}
+
+func (UnimplementedPositionServiceServer) CreatePosition(context.Context, *CreatePositionRequest) (*Position, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosition not implemented")
+}
+func (UnimplementedPositionServiceServer) AssignPosition(context.Context, *AssignPositionRequest) (*Position, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignPosition not implemented")
+}
+func (UnimplementedPositionServiceServer) DeletePosition(context.Context, *DeletePositionRequest) (*DeletePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePosition not implemented")
+}
+func (UnimplementedPositionServiceServer) ListPositions(context.Context, *ListPositionsRequest) (*ListPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPositions not implemented")
+}
+func (UnimplementedPositionServiceServer) GetManagerChain(context.Context, *GetManagerChainRequest) (*GetManagerChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManagerChain not implemented")
+}
+func (UnimplementedPositionServiceServer) mustEmbedUnimplementedPositionServiceServer() {}
+func (UnimplementedPositionServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafePositionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PositionServiceServer will
+// result in compilation errors.
+type UnsafePositionServiceServer interface {
+	mustEmbedUnimplementedPositionServiceServer()
+}
+
+func RegisterPositionServiceServer(s grpc.ServiceRegistrar, srv PositionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPositionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PositionService_ServiceDesc, srv)
+}
+
+func _PositionService_CreatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).CreatePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_CreatePosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).CreatePosition(ctx, req.(*CreatePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PositionService_AssignPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).AssignPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_AssignPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).AssignPosition(ctx, req.(*AssignPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PositionService_DeletePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).DeletePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_DeletePosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).DeletePosition(ctx, req.(*DeletePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PositionService_ListPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).ListPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_ListPositions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).ListPositions(ctx, req.(*ListPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PositionService_GetManagerChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetManagerChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).GetManagerChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_GetManagerChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).GetManagerChain(ctx, req.(*GetManagerChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PositionService_ServiceDesc is the grpc.ServiceDesc for PositionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PositionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "organization.positionService",
+	HandlerType: (*PositionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePosition",
+			Handler:    _PositionService_CreatePosition_Handler,
+		},
+		{
+			MethodName: "AssignPosition",
+			Handler:    _PositionService_AssignPosition_Handler,
+		},
+		{
+			MethodName: "DeletePosition",
+			Handler:    _PositionService_DeletePosition_Handler,
+		},
+		{
+			MethodName: "ListPositions",
+			Handler:    _PositionService_ListPositions_Handler,
+		},
+		{
+			MethodName: "GetManagerChain",
+			Handler:    _PositionService_GetManagerChain_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "organization.proto",
+}
+
+const (
+	AdminGrantService_GrantAdmin_FullMethodName      = "/organization.adminGrantService/GrantAdmin"
+	AdminGrantService_RevokeAdmin_FullMethodName     = "/organization.adminGrantService/RevokeAdmin"
+	AdminGrantService_ListAdminGrants_FullMethodName = "/organization.adminGrantService/ListAdminGrants"
+)
+
+// AdminGrantServiceClient is the client API for AdminGrantService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============================================================
+// adminGrantService
+// 组织管理授权服务：委派用户管理指定组织及其下级组织
+// ============================================================
+type AdminGrantServiceClient interface {
+	// GrantAdmin 授予组织管理权限；用户在该组织上已有授权时整体替换。授权依据访问令牌识别调用方，未启用认证时拒绝授予
+	GrantAdmin(ctx context.Context, in *GrantAdminRequest, opts ...grpc.CallOption) (*AdminGrant, error)
+	// RevokeAdmin 撤销组织管理授权
+	RevokeAdmin(ctx context.Context, in *RevokeAdminRequest, opts ...grpc.CallOption) (*RevokeAdminResponse, error)
+	// ListAdminGrants 查询组织管理授权
+	ListAdminGrants(ctx context.Context, in *ListAdminGrantsRequest, opts ...grpc.CallOption) (*ListAdminGrantsResponse, error)
+}
+
+type adminGrantServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminGrantServiceClient(cc grpc.ClientConnInterface) AdminGrantServiceClient {
+	return &adminGrantServiceClient{cc}
+}
+
+func (c *adminGrantServiceClient) GrantAdmin(ctx context.Context, in *GrantAdminRequest, opts ...grpc.CallOption) (*AdminGrant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGrant)
+	err := c.cc.Invoke(ctx, AdminGrantService_GrantAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGrantServiceClient) RevokeAdmin(ctx context.Context, in *RevokeAdminRequest, opts ...grpc.CallOption) (*RevokeAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAdminResponse)
+	err := c.cc.Invoke(ctx, AdminGrantService_RevokeAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGrantServiceClient) ListAdminGrants(ctx context.Context, in *ListAdminGrantsRequest, opts ...grpc.CallOption) (*ListAdminGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAdminGrantsResponse)
+	err := c.cc.Invoke(ctx, AdminGrantService_ListAdminGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminGrantServiceServer is the server API for AdminGrantService service.
+// All implementations must embed UnimplementedAdminGrantServiceServer
+// for forward compatibility.
+//
+// ============================================================
+// adminGrantService
+// 组织管理授权服务：委派用户管理指定组织及其下级组织
+// ============================================================
+type AdminGrantServiceServer interface {
+	// GrantAdmin 授予组织管理权限；用户在该组织上已有授权时整体替换。授权依据访问令牌识别调用方，未启用认证时拒绝授予
+	GrantAdmin(context.Context, *GrantAdminRequest) (*AdminGrant, error)
+	// RevokeAdmin 撤销组织管理授权
+	RevokeAdmin(context.Context, *RevokeAdminRequest) (*RevokeAdminResponse, error)
+	// ListAdminGrants 查询组织管理授权
+	ListAdminGrants(context.Context, *ListAdminGrantsRequest) (*ListAdminGrantsResponse, error)
+	mustEmbedUnimplementedAdminGrantServiceServer()
+}
+
+// UnimplementedAdminGrantServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminGrantServiceServer struct{}
+
+func (UnimplementedAdminGrantServiceServer) GrantAdmin(context.Context, *GrantAdminRequest) (*AdminGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAdmin not implemented")
+}
+func (UnimplementedAdminGrantServiceServer) RevokeAdmin(context.Context, *RevokeAdminRequest) (*RevokeAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAdmin not implemented")
+}
+func (UnimplementedAdminGrantServiceServer) ListAdminGrants(context.Context, *ListAdminGrantsRequest) (*ListAdminGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAdminGrants not implemented")
+}
+func (UnimplementedAdminGrantServiceServer) mustEmbedUnimplementedAdminGrantServiceServer() {}
+func (UnimplementedAdminGrantServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAdminGrantServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminGrantServiceServer will
+// result in compilation errors.
+type UnsafeAdminGrantServiceServer interface {
+	mustEmbedUnimplementedAdminGrantServiceServer()
+}
+
+func RegisterAdminGrantServiceServer(s grpc.ServiceRegistrar, srv AdminGrantServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminGrantServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminGrantService_ServiceDesc, srv)
+}
+
+func _AdminGrantService_GrantAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGrantServiceServer).GrantAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGrantService_GrantAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGrantServiceServer).GrantAdmin(ctx, req.(*GrantAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGrantService_RevokeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGrantServiceServer).RevokeAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGrantService_RevokeAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGrantServiceServer).RevokeAdmin(ctx, req.(*RevokeAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGrantService_ListAdminGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdminGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGrantServiceServer).ListAdminGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGrantService_ListAdminGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGrantServiceServer).ListAdminGrants(ctx, req.(*ListAdminGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminGrantService_ServiceDesc is the grpc.ServiceDesc for AdminGrantService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminGrantService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "organization.adminGrantService",
+	HandlerType: (*AdminGrantServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GrantAdmin",
+			Handler:    _AdminGrantService_GrantAdmin_Handler,
+		},
+		{
+			MethodName: "RevokeAdmin",
+			Handler:    _AdminGrantService_RevokeAdmin_Handler,
+		},
+		{
+			MethodName: "ListAdminGrants",
+			Handler:    _AdminGrantService_ListAdminGrants_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "organization.proto",
+}
+
+const (
+	WebhookService_CreateWebhook_FullMethodName       = "/organization.webhookService/CreateWebhook"
+	WebhookService_ListWebhooks_FullMethodName        = "/organization.webhookService/ListWebhooks"
+	WebhookService_DeleteWebhook_FullMethodName       = "/organization.webhookService/DeleteWebhook"
+	WebhookService_ListWebhookAttempts_FullMethodName = "/organization.webhookService/ListWebhookAttempts"
+)
+
+// WebhookServiceClient is the client API for WebhookService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============================================================
+// webhookService
+// Webhook 订阅服务：按订阅的变更类型与子树以 HTTP POST 推送组织变更事件
+// ============================================================
+type WebhookServiceClient interface {
+	// CreateWebhook 创建 Webhook 订阅
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	// ListWebhooks 查询全部 Webhook 订阅
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// DeleteWebhook 删除 Webhook 订阅及其投递记录
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	// ListWebhookAttempts 查询订阅的投递尝试记录
+	ListWebhookAttempts(ctx context.Context, in *ListWebhookAttemptsRequest, opts ...grpc.CallOption) (*ListWebhookAttemptsResponse, error)
+}
+
+type webhookServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebhookServiceClient(cc grpc.ClientConnInterface) WebhookServiceClient {
+	return &webhookServiceClient{cc}
+}
+
+func (c *webhookServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, WebhookService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, WebhookService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, WebhookService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ListWebhookAttempts(ctx context.Context, in *ListWebhookAttemptsRequest, opts ...grpc.CallOption) (*ListWebhookAttemptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookAttemptsResponse)
+	err := c.cc.Invoke(ctx, WebhookService_ListWebhookAttempts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebhookServiceServer is the server API for WebhookService service.
+// All implementations must embed UnimplementedWebhookServiceServer
+// for forward compatibility.
+//
+// ============================================================
+// webhookService
+// Webhook 订阅服务：按订阅的变更类型与子树以 HTTP POST 推送组织变更事件
+// ============================================================
+type WebhookServiceServer interface {
+	// CreateWebhook 创建 Webhook 订阅
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	// ListWebhooks 查询全部 Webhook 订阅
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// DeleteWebhook 删除 Webhook 订阅及其投递记录
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	// ListWebhookAttempts 查询订阅的投递尝试记录
+	ListWebhookAttempts(context.Context, *ListWebhookAttemptsRequest) (*ListWebhookAttemptsResponse, error)
+	mustEmbedUnimplementedWebhookServiceServer()
+}
+
+// UnimplementedWebhookServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWebhookServiceServer struct{}
+
+func (UnimplementedWebhookServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedWebhookServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) ListWebhookAttempts(context.Context, *ListWebhookAttemptsRequest) (*ListWebhookAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookAttempts not implemented")
+}
+func (UnimplementedWebhookServiceServer) mustEmbedUnimplementedWebhookServiceServer() {}
+func (UnimplementedWebhookServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeWebhookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebhookServiceServer will
+// result in compilation errors.
+type UnsafeWebhookServiceServer interface {
+	mustEmbedUnimplementedWebhookServiceServer()
+}
+
+func RegisterWebhookServiceServer(s grpc.ServiceRegistrar, srv WebhookServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWebhookServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WebhookService_ServiceDesc, srv)
+}
+
+func _WebhookService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ListWebhookAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ListWebhookAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_ListWebhookAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ListWebhookAttempts(ctx, req.(*ListWebhookAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebhookService_ServiceDesc is the grpc.ServiceDesc for WebhookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebhookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "organization.webhookService",
+	HandlerType: (*WebhookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _WebhookService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _WebhookService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _WebhookService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookAttempts",
+			Handler:    _WebhookService_ListWebhookAttempts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "organization.proto",
+}
+
+const (
+	AuditService_ListAuditLog_FullMethodName = "/organization.auditService/ListAuditLog"
+)
+
+// AuditServiceClient is the client API for AuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ============================================================
+// auditService
+// 审计日志服务：查询组织树的变更记录，记录由服务端在每次变更组织及其成员、职位、管理授权、Webhook 订阅的事务中写入
+// ============================================================
+type AuditServiceClient interface {
+	// ListAuditLog 按组织、子树、操作人、接口与时间范围查询审计日志，按时间倒序
+	ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error)
+}
+
+type auditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditServiceClient(cc grpc.ClientConnInterface) AuditServiceClient {
+	return &auditServiceClient{cc}
+}
+
+func (c *auditServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogResponse)
+	err := c.cc.Invoke(ctx, AuditService_ListAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServiceServer is the server API for AuditService service.
+// All implementations must embed UnimplementedAuditServiceServer
+// for forward compatibility.
+//
+// ============================================================
+// auditService
+// 审计日志服务：查询组织树的变更记录，记录由服务端在每次变更组织及其成员、职位、管理授权、Webhook 订阅的事务中写入
+// ============================================================
+type AuditServiceServer interface {
+	// ListAuditLog 按组织、子树、操作人、接口与时间范围查询审计日志，按时间倒序
+	ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error)
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+// UnimplementedAuditServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditServiceServer struct{}
+
+func (UnimplementedAuditServiceServer) ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLog not implemented")
+}
+func (UnimplementedAuditServiceServer) mustEmbedUnimplementedAuditServiceServer() {}
+func (UnimplementedAuditServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServiceServer will
+// result in compilation errors.
+type UnsafeAuditServiceServer interface {
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+func RegisterAuditServiceServer(s grpc.ServiceRegistrar, srv AuditServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuditServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuditService_ServiceDesc, srv)
+}
+
+func _AuditService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_ListAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ListAuditLog(ctx, req.(*ListAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditService_ServiceDesc is the grpc.ServiceDesc for AuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "organization.auditService",
+	HandlerType: (*AuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _AuditService_ListAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "organization.proto",